@@ -1,12 +1,20 @@
 #!/bin/bash
 
 # Generate the code for the API and callbacks
+#
+# swagger.yaml follows the upstream specification with these local changes,
+# to be applied again when it is synchronized with the upstream source:
+# - LockUnlockApplicationZone: the zoneId and forbid properties of the request
+#   items are declared under items, so that the request body is typed.
+# - components.securitySchemes declares oAuth2ClientCredentials and its scopes,
+#   and every operation, callbacks included, lists the scope it requires in
+#   its security requirement; ScopeMiddleware enforces them.
 api=./swagger.yaml
 
 cd /api/federation
-yq eval-all --inplace 'del(.servers, .components.securitySchemes, .security) |
+yq eval-all --inplace 'del(.servers) |
         ... comments="" |
-        . head_comment="DO NOT EDIT - Source: https://github.com/edge-collab/federation-ewbi" ' $api
+        . head_comment="DO NOT EDIT - Source: https://github.com/edge-collab/federation-ewbi, with the local changes listed in apigen.sh" ' $api
 oapi-codegen --config=models.cfg.yaml $api
 oapi-codegen --config=server.cfg.yaml $api
 oapi-codegen --config=client.cfg.yaml $api
@@ -19,6 +19,10 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+const (
+	OAuth2ClientCredentialsScopes = "oAuth2ClientCredentials.Scopes"
+)
+
 // Defines values for AppMetaDataCategory.
 const (
 	CONNECTIVITY   AppMetaDataCategory = "CONNECTIVITY"
@@ -1238,7 +1242,13 @@ type UpdateApplicationJSONBody_AppUpdQoSProfile struct {
 type OnboardExistingAppNewZonesJSONBody = []ZoneIdentifier
 
 // LockUnlockApplicationZoneJSONBody defines parameters for LockUnlockApplicationZone.
-type LockUnlockApplicationZoneJSONBody = []map[string]interface{}
+type LockUnlockApplicationZoneJSONBody = []struct {
+	// Forbid Value 'true' will forbid application instantiation on this zone. No new instance of the application can be created on this zone.
+	Forbid bool `json:"forbid"`
+
+	// ZoneId Human readable name of the zone.
+	ZoneId ZoneIdentifier `json:"zoneId"`
+}
 
 // UploadArtefactMultipartBody defines parameters for UploadArtefact.
 type UploadArtefactMultipartBody struct {
@@ -1632,296 +1642,299 @@ func (t *ServiceEndpoint) UnmarshalJSON(b []byte) error {
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
-
-	"H4sIAAAAAAAC/+y963LbOLYw+ioo7V2VZG9avuQy3T4/pmRJdlQjSxpdnOnp5HNBJCRhQgEcgrSjzriq",
-	"H+P82PsBzmucR+kn+QoLAAmS0MWO0x1n1D86MgkCC8DCuq+FzzWfLyPOCEtE7fRzLSYi4kwQ+OPV0ZH8",
-	"x+csISyRP3EUhdTHCeXsMIr5NCTL//6H4Ey+E/6CLLH89Z8xmdVOa/9xmPd9qN6Kw4H6qkUSTENRu7u7",
-	"82oBEX5MI9lr7bR2hgMUk3+mRCS1O6/26uj4DwBiwnCaLHhMfyGBguLVHwBFjyfonKdMg/DjHwBCk7NZ",
-	"SH21FScnf8hWRDH3iRB4GhLUZglNVhKa138IdnZYQmKGQzQi8Q2JUTuOeazAefkHgCOhoD5BE4ZvMA3l",
-	"EgEwJ3/E2rwjU7MsQ5KkMSMBwgxN2EfGb1m+VAGZ4TQEsIodXBBGYuqblneeBg2oUcOXSDDglCUdNuPV",
-	"r+XTeAlTRHjK0wQlC4I6A4RZgAY8ThD5FHFBAjRdwav+oI4a+bogP6RyxkgscBiiVBDZShCEYWQUyaEF",
-	"SjiKCfYXKFlQgax1RZSJBDOf1GtejSZkCWBHMY9InFBFUnE+CbFtwfXetlkAI8uVoxL7ZtgnnWDb1x2r",
-	"qVxJSVJpTILa6c+FbrwiTB+8WrKKSO20xqf/IOrcLynrqOkcZ29xHGM4ho0oaprRRxHxRXVjGqywTEu8",
-	"Qj5ngooE8Rla8lguNGaIM4KymdRRWy5y9jeSiy0E9ylOSIBuabJAGJmBeAy7LLtWmywQlTupllAg8kkd",
-	"23CFeIwoM38BAqAo5jc0ILFAOCbIrJTcaf0GBQrrNV5J9FCokS+8J5/Q2IYxB04AdKpBqzdCDC+J2IQm",
-	"cUJm2E+2b3Mjb3nn5cy8h5ekuhGXqUjQlCCMbnBIAzQ8b6Lj45OXKMRTEgJUdZTtJvyNlvqblNF/pkSv",
-	"e2FDa14twolc0dpp7f/8jA9+OTr48cPznw/0r89H3puTO/P8xZ//s5ZhkUhiyuYScr1TEu72uwdCPpYH",
-	"MiAzyoiAA26WGt1QjG4XFA6ttWnIxwx6hRNAAmgXERJb+1pHivDAuwKUEiN5RCTeAkZEPJbNByFOJCHS",
-	"ZIRxQ3dKX9somSZCYhkJ5kA8HnU5e2d/2HJyyQp6Z511a9g7k2sYEwm3L08MZ0hExKcz6q9ZTr2U+Zia",
-	"OFurKQcB2mzgfYQVLRFQ63w+hGB2AsISOqMkdnCx7J3kQIFiNzMSyx/Fc/c4mNKIokuS4BZOsINy22Sb",
-	"JDjACTa0UI7u4G1j/pGwrTxA48NHsvLkxCR9EYaqT3odlHEomLYRxdGkKcyn8jma0xtSpkVL/KlL2DxZ",
-	"1E6PT36AzTB//+CYP46ilg1pGfCzmJJZAXbrveEPIFLYbQxHKcJz8vpNAZ7jN26A3NRbPpUMU545Gw0K",
-	"MowZWB9chNEiXWImRZYABGg4wDMel7t5FGTycULmPF5VgR9wIagcXzehvyh4q/OpeTXC0qU8Zp3+uObV",
-	"3rYb3fHb62Zj2K55tYvGZad3UfNqV53heNLoXg/bjW5n/FPNq436zU6j2/m7ej+aDK/anW630WvK79q9",
-	"cXs4bnR6l+2e7LXZ7/XazXHnSn07GPZbk/zPUbs5GaqfnV5rMhoPO42u7KU1aTbGnX6v5tX647ft4cgi",
-	"APk6LPmUhjRZjdJIkjG1HFroneFQkKpaEcjpS7FlVjrmkkQKwgRN6A0ch1SQGJkRQLDQdDcmIZedBHXU",
-	"UqPJb3/79X/OG91R+7df/zffsinnIcFMwnpDYuHE/Cv1AlE24+592kIkNSLnQ3gFGuGinI0oGmgMVrJP",
-	"SRcU8rkFTIbvdWQRTmAqIbnBLEGchStEGQJd6FOivqUCzUhA4selo3/lo0HMZzR0HN4BjvGSJFLG9Hms",
-	"zCwBZXO5oXIuEYlBe2E+AeE4iTFVUiVhmPmrTAIliV+vkl69bHKVJTw2viVxWkG3liIOtEjYNBopNSZR",
-	"MiyPpQTjRJwpZsEtDZLFMNv08qwlV0FJjJmQHCz7wIjYS8nAny8po8t0iUK6pMkLF22qo06CxIKnYSC3",
-	"8nJKpQRM/JpXUypf7VTqNC9PakBeZW8265XMZE5A9QxxQpi/auYrXAW6q9rYQAo3VI0w5LdSbMRhSgR6",
-	"zjg7kGsX4Digv5DgBegTjDPioZDfwllNwyTGByG/raMJ/OzyW60SGbRQOiZmc4KOX6MD9PIILQXxPSSb",
-	"5s3yVi+P0AF6rVrVUU/qUUuCmUCvj2FMPOU3xKKrvX5PksRu/13Nq02642FD/nQSsjRMqDx1TaUbFzCr",
-	"1hgMrsc/DdrXo07votu+nozaw1rFREHZPCSKasn+Cygn1ycggs6ZEnWkcEjQP6R4CtogjFpHlxKMNV2A",
-	"kFruAeCOQtODsCa/Bujs8eWkO+6op64VYbw/kwsiBiSWohwTRfJ+XJ7/Jf4E6M2AiBobQ7LASl72OWPE",
-	"T7R4Z0wIGZkq4BtI5JGhJFUyJ5HUXhrZiVyuZbZ8teqhKFFtxwlxEuqChlqS9dA85FOQxbXWSHPaXNbh",
-	"4VDpzuqoH9M5ZTiRlLE/QHPCJJEG/YMKu5vbhRT89HfAItPpkiaJrXU8Dl3XY+wgk5lZlMZtHPxdDXH9",
-	"wfr9/n39QEJwfPKnO/fAWuqFrVwnVaNEvpCLmh0ACUrM8VIuodzyZwIFqewUxWRO5aYCbsDiL/iSyHXW",
-	"1gndm0SyTK7F/kfTq+QvcoH7A2NBgxOpUFrqbkTSNwHCJ1glYZNiEtCYgHoHuoyEQW6gHD/v8f6Ce3Mw",
-	"acT+YgzPywvTHEyQPJpx6sNsRyRBsjVNiJ+kMUHPO6PGC9Suz+se6rCEhB5qxEvDXTMBdNS4/tsPb6QU",
-	"qH5dv3ml/2gML+UfLhLRxGEo160ZE0BYHLqMYmmykC8N783bwjH2dR+ijkx3wpgkkZBYJ4ifxlICBFMQ",
-	"8dAs5LfCg70UPo+IQFjp7TMe3+I4QBFOFlXZQW2f6xwrmo9oABBxqYadmN0uwCu5mVMdgLYj4sckWdu9",
-	"gNcPHQIwdhKH2+xkk5hWSF32rZcvQgloF+lTcHe5FoNPP0tMHVhrelxe4jnh16HVfhOgF4RnXUt4cVD4",
-	"dBeT96uLw9cXqEnCENFAyFMYK7zRC0sF8tM4JiwJV4pfBhttkQQ3eeA4ZEOeAqGWLZDPAyKlxXGMZzPq",
-	"Ww/V+PnYUyI/ysetSmk+jmNtHDEn8bVU6brjtvPA+SQMXQhME+SD0AimruZFBz2nM6R7l6B0x+0XEuie",
-	"493rixdu6Ja+77CoSX1Miu4pS+KVXg3FFxhJbnn8UZ7Gacxx4GMhaR5l6iyT+Eauh5yDIoJKfP3xxx+3",
-	"CbNLth4QM+jvAEjpVJnNUwuloMy2yHWeigayO9eJ48slZkGXMgJ6lEto194EXzVVBnccz9NlLm4pCyJo",
-	"ODf8oxRAJH8qmjAzAUwekpgobRrkLiq0sTQTtaRKiSkDzUaAvT8K+UoO6KCyCq4dAAdQbSkuU3wk0Frw",
-	"YU5A7UNcpZQlO6QesBHPNyxnvoKZV0Szfv35fcYsI4pekg/uHY/a7GbdbrfZDY05A/3xBscUT0OinDcf",
-	"yUqpYyjCNM423lAByv6hRBFYRnvbC2pvZfsIu7mSvRppw5AlqSZct9rnnV67VfNqg25jfN4fXppH162f",
-	"eo3LTvN60B+One97I9fjzsBJ5wCKeLMkShxLs04gteVRkIZfukVRNe4odpCb8zQMQbCQY+eqySzmy3x5",
-	"RxHxyzthjNtGxAekKgIfpqSO2vO6IVxDInga+0QbWX4+/lBHliuzvh502DuHhQsQRdmfbRH6Pmt47eWS",
-	"/A93210H1h56RbRadw6yJXTYVwpuSbneaUI8s17Am6U0mPAYz0nVoEGKHtY1tuXcpqx3z5iWpXxhCSCM",
-	"kECtn3WUiuS1jjqzgtamZAHVYYBwYkETkhsSKr1PEN+mhAt8Qwojg70gl3/s4RjysZaaN45rE1wzMikP",
-	"vNEbbPsM15H/IgfbJAZWWZ527xaoYkZ5t3RlfeXmBE/Ua6wxvkQYdlkQ6yuIJoGwFMO+m5zN6HxbN61y",
-	"e0luVIRJFnzh4l3y0BXMrtnSmdAFIdVy8H8iQpOFpKfytGoXJ6jYQoqtMxPLYPtg+XKZss2+ISoKQQ7K",
-	"5brSLl01ruSlijCaoJnMRrCKlESpBX2g67c0DEFJQ8ki5ul8oeMjlBPXlhF2ilnJgoy2+FLpEs/JJvFF",
-	"kiho47Q72YRCt4qJ8UCTAKUCLPRShZYydW5+EnU0wHEiRb/+wKyr0HPWA6qP4cM0CjkOEI7ozktxTkOi",
-	"Yjo2LgBLl/1ZR4uCjoXopcspibV8WRJxhd7gEKfMXyjxsWJOr2odEYkFFQlhyRUP06Vr1LFk5dGCLEmM",
-	"Q3QDzRC25GUd0Qd2b8k2wMJElhGPcbwCfkWyIB0U4ATvumyDEnC7IlJMRILjZMBD6ju8l28BsQmKisEI",
-	"C8yC0NYeZpiGaWyb2oft0bgxHF8P+t1O86frRvdd4ycp8pWe99pXTntzVWS2KHWG/xU8KE9oLaVcJ3OU",
-	"yWPFKuBH6e9lfdvJ5uZHafuTH6aC3tDEsYPaE6sJqWpIUHMwEU7fVkDFx5ESmxymu6XU8uWhks0y8Yoy",
-	"dDFdJUTJViY4oTNqAMnc7XDNojnedIrPBxcNgbJYz3UjVTueR+nOAsNFlBqeWD4ni3ROIkNzd+rsbTon",
-	"A7mMjt6WZOkMFsgXeNi4VB4/ua6lJQS0qM6UpcvmYLINrCs/SsH7rdZl3XoDcqKrweQBi14+u9aJyaDM",
-	"1sB9EMGY5Da+dUZ99PL4zZuDY9QIowU+OFG2HuOoNJYoPstYlTy/OOFxVZX58PnErfm1HDJRNUCczlPt",
-	"VVBRPFK3VtIUqCA2n73EK3DQxFKO5iDbFHScXIcE5xbWboNcNkMh/UiQJm8oAvrmGWsECqViknfhOVU5",
-	"+djlRfdhKmtIGryDrbZJVKvf/Et7eN3sXw76o3bNq/1lctYe9trj9uj6stHrnLdHEGzS7U9a151eR8Wz",
-	"dC+vrxrdSdsdPKKjtoUTCHhjNDXfXvt6bTv3yOZnjeLCvBb4b1wmVcnaLTdcVWVUDqDntE7qHroYjDpS",
-	"UI0ghA1PwxWYruD7ZKXcTS+ceEeFL9GEBO1gTno82CTl+ZgFNJDK5i+cEWPwdkVm5+bgzLanvf2lYGsd",
-	"lxhxpqKW5KkqBm1oPylaEixSHcqg01a09QMM3uvt6mF54AdHhctZb48U/ju0ygIPy8ihO/HWwnXvQMfz",
-	"LMLGeLCcIT2TTguWdx7zNFJuSiV4CGOZ5uAUBrcjmUnREFvRO1lgT6eFyCcqtPZ9X9UyJjjos3Bl4mUq",
-	"GGnNRg3oPh1FL3VmcbbjBEGQzLUHzpBIISxqlobohsR0lkVHsEDp3IVYOXvugMUZ3sn2VCgiPF1lg/AI",
-	"jDIKrJUyqTByG67MKXAsZ8UVr6ZiLCBldzyVwK6QSKdCwmKdhYTf4jgQhVnz6Gvv0aYI24sNwQmhjqDT",
-	"4RiGY2aCf9kXgJXHO3CFmV22m/bCZpbKx4lLyOc6VCttIniLVIYymlActnDiYGpjulQ0sxDjV4CZ6jgw",
-	"jbe8gBS2QCbp70FCl8QFrPysPyjJM5tNNHnT7Ht7e7fqzy5UyHuin0jQU1bSpmEum9Xx/INOIPKelLvt",
-	"Pl0VvtB96YOxJnBg40I5Psk7HCU4SUWXso8P8Y87Vt0rIJRrHBebsHFV5Ze6kZUEc2I4f5kBPYAvztwk",
-	"eze0yT+582qhv/xycPhsJgWahtIjIHpX8uQNgg2IM56ValGhR+iWioWy5CzxR2IpKSA035BQfiIOO6Mr",
-	"kBo1VZbHt0rbdjWySKh3NaxoBPmSw5918RjnP+/sEUhA1tkjUQG9EU0cFfXrsjFqSVCsYy8OUL+CE1Qg",
-	"H0eABXLLdUt5uIp5DDo4F6y4ILLSREAzkYWZ60AuJYjRWAWMmXiUnBmCMoKFifWwPtUmVQj2KHUTk7kE",
-	"47mFk9kU1DsPMc4OdDDbCzTN/Dt2AHVlXhiMh46zAlOrowbzFzz+wsXL1k0qBla0XTbthFc/oqywqrAK",
-	"2ldnK5XyuY6tqXm1DFynsrjRw+4+OW7CWEI/JxlXNukvj/cEsyWYxu8b8ans8EJb1EvBnlUZSR6xrXGb",
-	"FjSPE7lZphRr6bsJyNmyXDPZnzJtmC806JtpeDWae6O6FuIbnsZbTb0bKbcVk/n4RtmZgnAH4p813NWk",
-	"upt19hs2oo7oL+ShhtQ1s80NqeuWLpc2bvSm7dCtNpdvA9htWt9xCIVXJOiPJCq6om9RqM+gOkIQkbgS",
-	"ci8ycYsKpDGuaGiDsH09BCLgwxi+bXdRl7L0k4cm05QlKTr+oX70CnXHIw9djtA7ygJ+K9DJ0fEJGp7s",
-	"LGqpCWw9uw+wYm83WOfHzSsZryvrW7Fnw+4W99rJUewTXUkYtchiIQs2zxH2+XIKzENZR7STK7cgubnC",
-	"PwPmJI926K0rK4gmaUC8Lmdz+IWwQAHx6RKHaBZj5epKwdbyCgV0ThMUxcSnohrtcPDfH/788/v3wYfP",
-	"x97J3fP37+vmr1d3L/7svX8v/qvQ5mWljZPxGJpTIeHzKL1c42u5GEyQ2rMCfXASv0serOGn8o0KBamm",
-	"t90QFvB4DIkoWI7ih3L1wOQgjw+Q/95Vp9VpeGhpd6Rttb/9+j9jIkKMLt8c/fbr/3r5g6vjI/nEmPMr",
-	"CzKP0qtsdPfcFXRqRIDGQNK4bJUdkheDyfVg2L/qtNrDa9Wu5hWfNi5bEtPJJ7yMQglM74YGFLuAY+ny",
-	"YjN9vShwwnXntDjJ4kZ51tZnI7pOYsZhKrjDAByH8YgnOEQsA1ZyNRRpf7QjbGAtzZdPTQeylbXgJ5dn",
-	"Na/2Cv5/fHG23UGejeIZuF2TNY7yTVbCSUWSNaKpy4hr21craVxFJUBqKDkFkyr+4xgEzaRGidPWN0wZ",
-	"Ay4HphoXYHb5GrMBg3avpdKph+1G66eaVztvdLoQ6zpuDy87vcZYvv7ghKcUzFPBrKyFm6Zkr1EPQsxM",
-	"mqm2tRZzVxfYhLPgJMH+Qptwc4EZXI1QZkan6jlW/YNe4muQ71+u8Yn6fLkcZFndxST3ODEnolRryHKB",
-	"9gdAB7PAV4yCFcNLXfKiYDCfNJ3pwlIxMtEx8A1mQZblYyV6FJT70iBWNI4E2xn8k+UAvHn9+uXrbekI",
-	"sC4xT7jPQ1eoal5IpDNQScEASiFgDUW6A6QciOPmwEOT1gDxGL0djwcWao6b8q9JS/5fvrqW/3N7VB9a",
-	"NsmrafTZrEAaFb4YbepGzpJ6lxc/Os5QNVmomhZ5vB5EU+qoWxiwOrgkK5bgmtfPyMfWGOlOIy0dLSBv",
-	"cncqn5fo173O1wOo2g0VVNlK3SzcoNXtgmgIiDV5KjKwE454ComHPA5NXrvGMhNZ6dnFoSBWOdEGLkHg",
-	"zLyvmabva57aKJqURsnCNC0MEGp9bqkgpQ/yUlROi1GOoYAvlKkmfsjToI7eSQBcQ8McPcmJskeF469q",
-	"aal4dyl9TZoZrVBltKIoTybNSE2RYlhH8aoz6px1up3xT9ftv43bwx7UyrCednr66VYGXixNViApFuWt",
-	"4IWbyxdOvSMKd2BoZ4nG6qXIaNE6Wo5sC5WqAaPDoatl5RAvGv/0pLWdK+HadCaPX+6oJY/tNeww8FtC",
-	"LHiVIUfmceW7mGBdLHCb/CV7cO5GdPOqEQQqqS+Tjo9//KH++rh+fHRUPy5O8Pnzn+U8/vXz8cGPH9TP",
-	"Y/hH/T75+ejglfn9+uejg9cfXrx/X3/x+eXd/T90L1V088YAjMOwP6ud/vy5COHpv54f/flfz+VI+GAG",
-	"ncp/pexw9+LFi9MXzze9P30ht+xuWy//WbvzSuM+//n/nH7479MXn/90p36+ePGv/Ol/qUd/Pj2tPILe",
-	"CurJydHR8Wkw/eH0h9f45enpD/iEnL7809Hpn16+fOValUuV/GiB8/598HlN3tAlczU+8dY1L/tEKgiq",
-	"Uy83OlZ8X+dG7m5ek3BuNrG4shK1bcaRMJtHutq5Yp9MKOunH94UdcTsTWVJAip/T1Njk8jCit+2JYmd",
-	"nE164wlUMxq2+yMpnrdb/aHUSt91eq3+u5GpU+SUi0LqEyYKQPZH191Os90btVU1jPNhuy37KD3u965b",
-	"7ctGTyoCvf74ejRoNzvnnXbLOY5VYsga56o9HHX6vWs1ieuTk6NX193xSI1mXsqJXv/gePan4rNW+6zT",
-	"6F0fHxcfq3W5Ho0bZ109j8vRtV6b65Oj45Pr4cmGNarUfbO2trQ7dpkjs7AuQtiHX0MSFYxNZUIsxC2P",
-	"A1cdIfUGtBtdCW0huUnEBU2Umu+g3hGfDLs7xQDolHp3wYK8bpeqFHEfICTzcyt6EyHliyW5R3eu87gu",
-	"9n9NEdbLLKzWlLMZvqsUr7lSlX1wTNDwHXoeExwc3sY0IS9Abhj21bMDKTe/CO24f9nXsO9O4zSpEblI",
-	"u7E2WIJoVh4sKaRWGPs4V4FyjAdEyzM6UFTVHwJDpPHBznI5Tpg4Wre/RSywBNlOijBL1f5bszsZda7a",
-	"lRVrZ34cHqPRAsdS4+jMkICfRmjWihKfWZV6cqigvoVOG4HMoinR3yPsx1zjR/6BbbGwIRu9bQzXkSTo",
-	"/ZKnLBngZLFZX1VOCZPlCqVQ4PPa2o7diP52Qym+bT26bWdC287yHnRc+nSlA3GPjy7OPHQC/399hC7O",
-	"5L4cHx1dnL2wVu346ILWvNqJ+ue1+ke2otuJogVfdV0LC+IihwPOnbUb+oMsPdOq7GZWK+I8RBlOm6ed",
-	"0ZVdKqyaf9gfPI4YLYFeY7QaXWVw22q6cUkA5HV3n8qklBlZjlxGllIV7qqzFqeKoVdFCfjE+YpaWsHu",
-	"UlNBl9jmoUpoEhK3/lBBCZN5NIT9BX7T0r63NTGMEYkpV3igjCB5DHGeyJihC5A8SM6L+RIxfqvKxm0s",
-	"4QIJVi282phjF+CVKA22s6u1P7vkLFls7H8JLR4+wk8ExxsHWMkGD+jftYvlCDjJeNlKq1EFP+M/A1b7",
-	"IJWcgiFA64tECCKcr9/Yrz+UN2ymvXwb4wRkG4n/hbF2xn+j0W7NUi3Aeo/u3+zUvakwujEvUrapKOvy",
-	"oYsmq3BOW1y3rP6Xg/6wMfzpWj6aQEnWxlWj09UCdrff/EvbaAX5CxcLluKmixpBatYGJL3xoxRyA24X",
-	"PCRe5oNVrtclDUMqm4AxL/sLKRwGScHoXXII+FT+vFElIo7qr2G99WfWm9rroyOgczCs9eLk7s4r6bj/",
-	"/fz5+/d1qeweSyX+X8+XL9ZwkisaJ5B77DZvmrqwxiZr4p+amSlORS/xGF1d6t/P/9rsvzvxUP+qYbN4",
-	"eFrzdMqS1HmuGs59scM7q55kwucxjhYrq8XGmhRUfinhE4mch87qMaY0qHiOip/YSeYh9zGUuYUQoTmK",
-	"0xiHHkrjKWaeFItTCTcOwVOrwxGtwhBZAXOIPSxVQjbxUlWvMeEPrNb1uJk4NhxedeVdJ7fU9U4SqJZQ",
-	"Hs8TKYEY6jp/JHCHepfykf+a8gR36ZK60t4u8Sf0T9lA6jk5Z7fcrzgM+a1QwYAZmy9EgexcKcNRGGIj",
-	"9dVRMmJkYmJ2JvAm4G5rjn+x+o3jUAZEUYmg12luopxZvGHmIPHB1ahkJl3+N+ssXBWK4hTKm/NAIDhf",
-	"XKVo6p5FdgYhxkOgGxonKQ4RFuiWhCGKFitBfRyiXqe5YzAXmUvGeYZZ8I4GLk1NokcQmsITEVAQnCi7",
-	"uvKWYIYg+B6UgyJNuJxGon6vqLLWoPUXB6HWviLIptEtBZJtdXGwvCZP3a1sq29GMeU3u3WPRsNO/ypb",
-	"+vWlmssViEoL6hXxpwRKcd4ummMOXOn0OA5y9iqP9gxXVTXOJBnxWCtsX+3wPi69XrsQ3iZy5yIh60j7",
-	"0KrgujHtC/s+iZI1ySY7J3kUUyc2riTEFcpvejyhs4dmHK0H2znCbsu0KePIDKi+Kha82HmZSrxuqyfB",
-	"NeMKANW53UF9IeWLbXHXRUsXo8uGh9qH7846qDHoCHRzXH8JjrU0DmuntUWSRKeHh7e3t/VPnz59qvt8",
-	"qdR/13Va/4E6LIl5oGp4vGcHBwfv2bA9Gs/SUPWuKhWGqrK5joICM10m2ilHta2Nw20FkdpaKRhCFVui",
-	"vN8qhiBn65qhw3zq7w0I/yEHRyOfRyR7BuBYVB1opTbHG/nGhCwUat1nt1EoVzzX9XLlnExlU3H6nv0X",
-	"ur7OcyguMcNzyPi+vkYHqKmScJXjXL5BWcFjK5USihlQzsSCRub2qnyqaogy2ktEGK2Yv4g504Z3GDEH",
-	"QOV0mdVVJfiMUKSS4VUBOIj5SqMAJ0TosXQ1tNJkJpBY4aGYLPkNkf8mMSU3anqqA+dlKDwWHvIXGGpH",
-	"sQBF2P8IZZDyLcwim6oTP6ch+UJAppThmO46oOWf77Mpx3FA2bwEgTnW+dheNjALNGClKI97jp2X13Di",
-	"lBmvNPuExEvJH93he7tDYW7aMHSnBMMowQn1c8tlnJvjVAkU5rzyxh5eNitjpBrelHUYKR8DDCifocDk",
-	"f6oiUUEKMRJ6GnbavE0aZI/ds/5QJWIVS2tD11eFLHq7bDku1uEGk6Aui26RHPAGUNhk/WxsFybMKoAF",
-	"eTugO7BTPORziZk+Xy4hwApC2WMTYQNiq1F8JW2z6LAAugf7pRkFAn4IkzJpROUVLmSMFtOrFlioZIZ4",
-	"qYZPhXJBmNS/Aj7rkU0CGgxq1T3LCPkqv3gBO+5TfC4SCrUiXUmEL9SSuzE51kGrcon1uDDDeuUkIYPM",
-	"AGTptqksEdZDPDvtaEHFoWQ4laAriHCLSkVz8gzB55ft5ot6kYrCqK2MFmakEI7JKuNsQBPdVyBYRTer",
-	"zAA2HcaA3VecN7J3Qms4OQuGnou7rztWxV00iZuROM/2lHJJlBfZWapS0tECLjBJ8DQkiYc6/TH6SBMP",
-	"NYaHV0MTzgT2l06l/oEqVZhfpWllR5qL29xI1zIsNGe7ametB2hKkltCGEpuuVyFHG8aQCfPPGUllGdB",
-	"SSPl/BCVnKo8i9MVOpNwNTxINGVc13FpyIdn9Zxs2cFdCqYiP8w05jgFXJLrkAtDVj9ZqjvStvLyAdNX",
-	"5RUq0cBJC4mfoCWXxz9KwPgpB3huYgRzzFDX6TgSUU2BVkjjLRFeM6ylKWMB4bdal5VEimSVckoBcFLP",
-	"PzDfnf+11ZOHoGOQDyi2ovZYJOiWiARNecoCK9KtLG9pVLXkJ7zkbI6WBAwbUlC8XXCE5zFRqKWOQnb5",
-	"Z0Xo1HKei3up1uYiCyDi+TBlwLLs2fLGmekrm5idRBATcMWzRFfGInGCKVsnKFaWwvKFwpgdK+mgSqmK",
-	"HZvKLrnIpQFvsAfBXEAosDxnWbnT1TrpQA+uNF59oqHyj5W/5eWV6gvVi82hze7OmWalb9RNVfMYs0Rh",
-	"K0ZC3zvEaFIYtLSC95y8IEkBUgOdhNQqu5wBq4Z+qwQKJdiqsF9XCaNilR64mQXIeM7OnVwN2Iwl05hr",
-	"bI1WWreA0Hnlm1m50l+Kee2llIJMsNzGt80VMzbfNqkqFepp6oJa32MV8qxlvOblfWilpH0hnRHkr/yQ",
-	"aO3MqE0VxlNHsvuvSfz6qgKIJcK52XmJlWtyVcqUV9DoyJ8oplLUkBQQ5ge18LivgratGnEajkwIUoK+",
-	"ujpJlQsvVjngwL0MQdFfKzyulE4wnevnpXM22nyyQFa9ZcpObY9ZR314nF/kwRCRrCKKqSAewmg8gvQQ",
-	"KJqsBC0ez7FRmDVQhbUDgMb6gKl4JKhzlFX7LVXYUmR5fT0rKXsU0KW6XQqMHF+LS5hdcqtOOuy/usEa",
-	"WHMTrCguFqbmW+lH3bRra0dGx1Pa5dZC8cZzUvwYDTgPtxHt9Z25i1FHMTnQllPblMHKao2nRcii8JbH",
-	"tTHQtoBjG4OqBr9oyvwSlmd8cODCkMSFz+EnXHajap8AWSkavHJBJM4rweWgoaZSyHJZZ2A0X+um5ZIi",
-	"h/2PjN+GqlqL7QnLXKgFhQnRxCoVlAojZE0kadd3+2mdcr5Ibon8f6Yh2xYOc23DOum9uMlKhpJysL4u",
-	"XfMnblRBow88n7SVZqEeVJWLF1IPLlgAFdJbGvl7ts5Ip+aqbColsVI9lPLCQ412FyTJ+9S+WS2xKpuN",
-	"KN0XUKIw1TGKpKUOlEqzNVKqwwdJ1Hm3aq91oalSmqpno4l9PZDwCj5z1RspqgQSjW3uKNU9o02GxF7V",
-	"4gqAiQzKMkK8VT5rx0RVf2DdX+X9TZTRUm0WVFPE/kepneW23HK9Q2WJ1qa68rlRVzawldTQmSot4YLP",
-	"IYUrSMr66KCTje9g0m5u4Vnw6Tem5q1lG9Ak3gGdwvWdrMU5mRmlU+HHdKpYgLo5rEA6QAopLRcVEICr",
-	"M0+t4Oy8SH5uZrb6UlhqyLrRuy0h2SKR2u0nSEFGlCBPmHgI0BAyzDgKOZuT2A11ibnUs7MM0S84wVuP",
-	"sJpUXqizOrGyWLfAoujsxGwNHOoQgHSIrDveHvcQqAOQVVnN4c5gLBjCNHiAeQ7fgTkjUgMsWMXUI1Eq",
-	"0aWvYrHYPF8jTyoiUuhSPRIgrZqewFazra8LkpQ6cu/vvTtWk5Q69fW1nrHbO5Hw9X3Z89VdWXN19bYZ",
-	"NtXfFSW3Vm/uCWc9lm2TJYVg24CAHdt8KwUlpGxMU8qIKA9swIWkfps5ntlqkGllhCwPVYlSwNGU848f",
-	"CYkom3u5f8OqtCvPs7bZxuTAKj7OLaEj5wnlOWieVZXLrCNXzvK17ZKFm5o89Nf+COkLGzx7g3Lfm5RF",
-	"c35mNck91oZbOxfdPlDrdtswaIlP1e8NWrn2zNmN3v625m6NKOqR21wxvoRqlkVw8nJTcNvULcJBAL4W",
-	"HNqupS73P05YyP2PFpiZAf2cx1MK1qKIxEuaFG2hUNWq7AqQfVdQGxkjBurSGVEGhiqSQ6MwbESRZmDZ",
-	"xViVxS4Q2wpb0ldNywHdMmapt6wCyZpNKBJXDd44dyyu6W0LkDDT7BqSzSQWPEKZy7JajsTh+7HMSNl+",
-	"bHJi2nJ/dvMJ59naAZsTFud7vskC+QK5p4+guGpubiterRxXR9mwiIpydEZXQyIyZduQEweHVldRQDaM",
-	"srl2Rlf5MS31k29FJSMl77HUjUKQUkdK5t+1G7VVFYfve/ZfgDRNU70/P/wPdQSXpW9jsynKREZ3wlkN",
-	"uDUXCKw5AyZ0RN8gYCa43v8MM7UeEssDpx3TejEzZzRN4OYOXLTBlp3T79n///+hk6OTE3QxukQNTfaV",
-	"na8h5W+pxufYUn/PalkmjlWU2Y4n0dZVK5v1tHZcP6ofQQXjiDAc0dpp7WX9qP5SBQurlJVDvcYQYsXV",
-	"nfjZHdbyD84Gdq3o9g1ROSGf/1PvUn3Kg9V/HFYqSt/ZXeq2ZzxY6Rs7Et2PtVWH/9C1ClSQliPqKwgc",
-	"NTrvWfwXB4ErYf2+RX9xEHz/VaCLhb1v/G+iqHfeU57vshEY1UqXA/+iSSx5kGlu7tL8LRNmlNA8RN+y",
-	"T9g9uIrz5yRv9yL9EGdYvuH2vN1qDxvjTr9X82p/7/fao5pXa7cu2tetzqjZv2oPf7oetYdXnSak/zQv",
-	"rb8u+2edbvu61x6/6w//ct3st+Dr887f2q3SU1ciSiZsl2EajRvjiexpMmg1xpCC1GpBjbLL/pU72SjW",
-	"WtWXnnrVz2McfNXTl5/93NCRUwHwG93rJN8j1FfClJ+YNbfbiHseqceNxNaj3/PKmnL9U2eVbOuUlDHU",
-	"ca7dUbyVTOdUmEJAhmciHFG5k0xZgtZokiU/spq2V6ATJMFQ6F0qidouDEkEWhqvo0YY8lulKppaqsAk",
-	"8okqddieKsIxec8OUE4cpOYOp/IUqU21bG3KKqWrjT0rk91nddkTUJbdO8lxsPh5o9U6RRNB7LaGwz5T",
-	"3FDdXRwEoEFqtTHvQJGQSh/WYbW70TGgRik5QG7CKGV4oFSVftcxxmf2vawSUONxBmAtKru+6yKT2tyh",
-	"i1CvX80yNbNXRHat48YKPob6hmE2rrlzML3uawdycJj10ylR+fJsVPX1XcfYOBfXSHoq7lF0eg14foCY",
-	"nhy9ctQy+xQpz1XmJFJVkvNbpfzMZqwjLDRXfHV0tI7kZsMeykbQ9niXtseq7atd2r5SbX/cpe2P0Pbk",
-	"ZIe2Jyey7etd5vZaze310ctd2r6Etie79HtypGou6IIp29qbhnfwn8VYJE+slT2XNe/BitBucrKdW3RX",
-	"5ItJnJIqVh59FSCszB0H3zwvcrkD8IVmV6HpcOmaV1sQHBBV90AFUR+0mc8DeQSqRY6KDTxtY52qAINO",
-	"e3wO15//6c2Pr6SYkc2oWtVC39W4Yaxyi7WDnbw83jLY+rLlOlFcl5MddvKyofb9b8aI48l143FeaZYg",
-	"dYtvGpNT9BlHdMh5cndopFAjhB7mTP3w5tjo8IefHSLUXa2MTZumdrcnUt8ikfJqIl0ucbzKiJNAIFRC",
-	"DAW4Dkqu/vUONKmK4rkAXdMRvVH7IIcroFJ2m+TdIVbmZvPI5B0ac1GRjDYcjb1a7nyHyiRUnppIlS5i",
-	"UOKn5hp6IxbvaIzIO7u7++A9lm0rirbrUXIlCgofzs32u9RIrpRPL3WhEwq3FdVRnnkQQ80nG6GG5oOs",
-	"eXHQrO75LqCrxnB7ihDOS77BQFkIjOGxud8d6a/quxWme6C1JzM4q8oZZYuP5cvZ2cTzKFq2wrAyzni5",
-	"9l3GhF2U4J4dyxDhVchxUN9N/G24jPRqzQoRElbIXLAXfr9R4XcjqVca/87E3tF8T+71HUxyZSqJ7sWu",
-	"darcqNDWXSTRij2wDqMaRZcE0U2yMG7rcveCly0RJJyhanpp4WSnwnlHRb931m8MW1B/qtWG3+byCqmV",
-	"t/LrKz58LeKYEcHq6n2ZNdJQXbGpw69GVwsZ5Hvq+QSppw5nuxcJXf/N90dH9Vx3IKZ5yzuvpk5E7g7Z",
-	"Sh9NWOGuxBHCAsxHReqYPd2BNLaHw/7QusZn0vtLr/+ut0vJ62y6pdl+bfJTXKk97Xm6tMdZG2gNzam2",
-	"/e5ozeOFMDxMZFHfDYmYREFFBtyqs5vYkiZfRk+p1lcGeI8kBbi3zbhcXe/fsWbdH1CyrsxbHNLzA9z3",
-	"mXxeOgL3ZmbKDZ9FP2IrGcfF4I6qCwTZHeC02Jspvh9mN6MhuY+Qfe5u//0xvezy+M0RVyF5mGANlZHv",
-	"I1SrUsq5QK0ffE1ZWq/B7ytHn+cLs5ehnyxZqYRFr6cog0rT709b3wdw7wO49wHc+wDufQD3PoB7H8C9",
-	"D+DeB3DvA7j3Adx7Le4b1+Li6s12u1mJhls+/B7jWkxm/07xLVbjR9UxdElRXTt0k8A0M+VFd7z3R/XP",
-	"0qW5B8gRu52qUpW66x1v4iuIQNlYhaHuKVd5tSi7pXPzLXPQ6rGkwS0W/CKKZDBW9+wrG9hUeYYADV2F",
-	"8veGt6dIsrNkhUObZoX+ckN5Azu0eEN9A0fE+teocPDkosAH33sU+OPxpO8sntxN5vdR5nty/zumWOaF",
-	"uu4rS1s4+8WidH7Kv5GEoLJ2svVCt8diIl8g/OMoujK1i8qnWL9QYRfVomNraZzmS48h8ceqkNb6iClV",
-	"SUtdwg1VPaHI17rbO1TpQx1nU1f1pBDcMkRnqNNGz4zO2eRMpEsY7BmCu4vgwoFnw/aoPbxqt66H7dH1",
-	"6G2j230mmVzx+WDYPm8Pnz3K9bAOgNRi6INcK4zcuOp3WrUyIR9ps6IwFyMXyimqAl35MpEApQKcKnmt",
-	"8TzpVUchQeXqvCy57MQuTa6rrOUFz63vGSLYX+gdcC6ohCVO/cSUZRVE7ZGq3e8AStTdO5D3lHA0o7FI",
-	"UBKvNk3Pk0vEOLO9inMOSMU422Fk2IHiFBhPzDTcS0pnKOICbmHwEIUANoz8BadQkTAiDFKM00juFo39",
-	"dGkKARaHPu8Pz+47NoTGGUdTdS/Ay1RZ1/JTg3UOaH6H3JGcsHxwxcGV00Js8cgSmwrKsUUULZLmpvO7",
-	"SFLFK7sLVTpZUCyj5xScFiYqx5TRQ50E4VDkBn7L5jkZdiwPWqWiq0QFXejYVF3UYFlellJgj0vqO9lF",
-	"6jNkt1JoYC/rfeuZ6veuvmqlpm+5tNGRpb7WhiD/PvwMBxXemXMKz/JDe6eu23cYg6uVVr8RmdVzjmtI",
-	"0sNGKkmm68cokLsHj2WLkkoG/4I6J2ssxQ4byeaWxRv9v8go8xD7iJvlWEM4Rri3hflxOeh248DmQIIK",
-	"v3NWfg7y6Kk96f+mSb9VJzsM15S2nhVjrr425TcDqwfZub07lEh8+Fmh8p0SSUKiDm3ZKaiLd+/Jf0kK",
-	"fthYLuK5ZkDrzv+HjFQhYx92SRhxmiDNZdB7mfRJEqYHlty/F33y1ouRlVsF9qTkOyUlOwut37y77m4H",
-	"80Rjw1UYe6r4lMS1e15r8hUEt/zK+A3e/+qlR9vjAKppf99MJMDjOav3pYaeXKmhzQ75fQGiPQm/h2O9",
-	"es3bd+hgb5pBRxHxxQ7UtvSBEopyLrUmqajodCnfiqX9LWVvaO5qWeLAcgL+P0hRyxklYaCuwc6TNeAK",
-	"zdzVqK7KhmB3ScT8ZKM/OlyZ+/hCClfzq/SKr5TN9OD4hkuSYKgtvf3brOljBCf8lY/0bX47fG01Vl+7",
-	"awtsjctYX8Oz7CbM5loG1nNg+jqQvsyFuOU+ROt+Q1OdSnjor3yEIgUq+CBjModMqKoXUnkNKwcCLjWu",
-	"ba91vsVNqLkxeKT35pgnpng8ldtH1+g5rvtWH6Dn2HbqTQZodQ/gv5cBeldLLYJFA3KTpXHtCcA3TwD0",
-	"BbmiIkaBoQGzlcnopjP5lweHNbDuw2xE0f0O5xrrbOma3f0J+/eSzut74fxpCee7C9hlRLq/1P3hnhbw",
-	"veH7CRq+t1+kfk82E+HEX1QZTeUK+383VvMwG9BWN5mL75SOaFHJWOIV8jkTunLMkgO7wAxu1skmU0dt",
-	"7C/yvyG0OleI4bIdjMxAYNwOoGvyKeKCCCTVG6EqGglEPiUkZjiUXCFGlJm/isHwAuE4U8ID+w7t6p3u",
-	"yYIIC1zhySc0tmHMgRMqSQ0atHojJDe5yIswW/VngIQ2ddXw9/CS9M4kcq993X5XfW2VtP4gUeBxKoFn",
-	"DXqAqZWKr6lIJJvHStODm72Oj09eohBPSQgzr6MMY+BvtNTfpIz+MyV6b1kpYeXLEzKKy/UwyEFaCbSP",
-	"RkoXZjvRDcVW8HaOtzpLQ7mTSQDtIkJiC3fqSHmP4V0BSon1PCIqbjcMEZSuraOBqamklG3GE432pa9t",
-	"tE8TITGZBCqT81GXs3f2hy0nXIvfO+usW8PemVxDIzIqI4YWMf01y6mXMh9TDWavphwEspEMvI+woiXJ",
-	"qnjO7h1niqNoEgVFMc9NZEKcEOavmlxK2pgqLlpsMsUsuKVBshiah5UmUOKGJquRqoDsaJCGCZ0IEjdD",
-	"KpHeQa+0DCkoZ3JFgGgVkWqQMWzk81hJGfYtfhGJIaOX+QR4jJqQJM6EYeavMkKubaDuYhwGADtbS7Ff",
-	"l68WVOQtaVlrC0B7jrV1pTljlMSYiRmJUfaBbS5Gz5eU0WW6RCFd0uRFVnjcggxyT7QiBuW1qSTyxHfV",
-	"29C92ahllduuoIyrMpxqU7Bpu6Eyhb1ucJgSgZ4zzg7k2gVSvPuFBC+ALTPOiIdCfgu8NA2TGB+E/LaO",
-	"JvCzy2+1ZGHQAsyYMbjIj1+jA/TyCC0F8T0km+bN8lYvj9ABeq1a1VFPiiNLgplAr49hTDzlN8Tykvf6",
-	"PSgl2H9X82qT7njYkD9dfvDy4bARa4ZDUcGsDlO12IWyvxQ1XSnXMEETekN0hliMzAgAqMbAmITcV0lH",
-	"LTWa/Pa3X//nvNEdtX/79X+d+Fg5p5WdHVE2D4kaN4Eia3ZaVCylJUHnTElQKqvwH5IZgHwHndbRpRxl",
-	"TRfAEso9AFhRaHqwoxUag8H1+KdB+3rU6V1029eTESS6ZY8vJ91xRz11bQ7j/ZmcrxiQWAcKFjbo2KsW",
-	"tYeTxiC1VsOjCtvLlfc5Y0QlLuJi3DVwjALqA//Ly28BpwrJDWaJMmGUU2JlJ3K5ltnyuWvjbC0q/3Xd",
-	"VICFf+3njipYHEaIOpRTk8cWPIIfSmfE7Z1QT84IoDRysa5mpCgHP23BQ4lvWtjx3GqYZMNWXFXeJAf6",
-	"d/M3HeIgoPI9DjM7q7smmh68/YlCJeRGFPXIrTGp7S0Yu2enPZq9dxvxzAp27u6UfzR/vBSG97TwydFC",
-	"fcjBI0/0SS/gDbjkDWKBiYSy7QHBX4Nw7Zy3pX2M/54m1z8qCWILlQjUnuxJxBN22Zf0wZK3RHGe35cc",
-	"nPN4SoP1IkyX+x8nLOT+RwsYicx7CeZeEsyG2vtrq5AYl3gmeMxgswICNf6wsr1Ytf3W2BdLJaGyDS/V",
-	"ncJhStAzuU7PlP9etdy5rlOPA5Mras3Oske6oErxe6dF45GL1qipf3AJhdtEuC3Jrqrrw4jES5rsSfTT",
-	"I9GKDh7CodqM8nl45S+KDD4Ktdbml/WEeBKFHAfGofcUMhSU3Q/HyeGMx8uDQMfKfJ162mYBW/YRLZ/Y",
-	"s5iSGbKeZUTKWL/0NTc2AhgXc82rLfGnLmHzZFE7PXn9xmGQLEPBY3OvTBEQuOCCz2wDRxQToSq5FiBy",
-	"1HwjFCJvdRUq/RdGb0m4RP4Cx4m6LG5M4hgr3xiMASYUzhJMJe6KiPh2GbK37e5lzauN28Nh47w/lL8b",
-	"vVHnrNuuebXR2zaUJWv2Lwf9Xrs3Hg3aTadF1oB9rv1WxWkDiDj2F/SGHObwmSdF6NRtdzxGZ5TheIXo",
-	"Es9JNZCgamhSQQi2a2QKPdS2wHuum6+75lmgkH4k1jrD7aDlZRbgy2BE26Zi5etUoNgL/q7T+3tnIJe8",
-	"MYSF/9tY/XHx960r6/bfy6fZNU40BJZqYezLE5ALzJ8/bBjjvoEF5jsD1y5fQlvr2yGJeJdrZXNLH30Q",
-	"dApfWD3pKnLuJM3szu4bq86mrpy8CUGuaFy5t+rqErwUcDB640an1x6qB6798+1Qm22hmdpe6ln324Jh",
-	"XiQ8lqeg4pkjxYibquxVoiPal2gq2UG0Zl5BWmKv8uBYrtCiN7+OOjPrfneBpkQCae4AxokFTUhuSKhE",
-	"PUF8O6h0gW9IYWTwNqk1KA8nxUdBSrfNO8a1yYEZmZQH3hgdZMd33Oe+53x7t7n4YxJxN2fI8JPHigDK",
-	"poImPF6hkJu9HEzOup3msD3oyyWAEqx8hqJ0GlIfTYZdTaouaPI2nXqKZMmOPBRw/yOJIfVIJPEKvOke",
-	"Ggw7V41xu9DhDJgSvVEumoijJYgygYtHZhcAemgy6PYbLdmL8RjDtkGAsNm3Q3WRqUApCDVyMKkJX7ab",
-	"6JZMIbYDh3WVkqK2K9U7o5gAtP7t1/9XgjWJw2eILpckkEgarhCeJTBBn9AbiC9YWC4mFcvFkCAsUJUb",
-	"1WslHBaxDHofdst+aVxeQw/KO+qHNydokSSRuVsr0MENaRjaxN9a75pXy3cT7puT67f9plaLVjtieG0i",
-	"66aMDtK2Vn4pU6+dontZRfWzQwczn7AdSlUJ6NM6rnVdkiBS7k1yGuCmf4B5K51gn9fr5ExwSGIqeDwd",
-	"NGMX7+bRhtObIXaeWhOu9mrgE3Bsyn0TpbgIQ5ILZnvUsLgQRr/QSBFsLcVK0mMkQsyCQ0lNsf8Rz+UL",
-	"2VDT6Fx+5LEi1Cp2rhzPqowm5biEovhbcCVo6O6pgB5+zgnLLqXdvi19dI010CaVDzQJWvLujgl2Bjv2",
-	"WXZPM81Borey2Jud3FTJx3XaNlQ12x+bR8ia25uLvidz0d78sje/7M0ve/PL3vyyN7/szS+2PLCTuSVX",
-	"N/aZ1E8tk7pEBvOT+gUKPQnmhPGAiAWWCHxYuAZ+vZv5giRNzAKwkX1Lkcp//GVzX6BtFNb+nIZqKbdn",
-	"aIc7CmQq3ycXxu52uJOpXPPBfZXT3d0XKm2bwDZLQoJ2MCc9iawu0maCpXyDlzpO7luhcF+Jan0hdZFL",
-	"igKDdOpS/iCNiWSitxCMaAUeQipu+/DdWaeO+jGdQ7l+NpevDO+2WlvZ9RhqtDh2xwS1ralbW4wEq6OB",
-	"lUgi2xOBMNz/x2dSDkuIpGHIj6n8hZ8JlV0WEj8pD2yTTINWI0UDN9JLMMluC785V/VO9qE3NnXzF8T/",
-	"KNKlI5G89RqZtyCPXl2CDCjX+mAKhelAohQeEgt88voNCuhcItvMNj4Il2I4cwaYfM1QETniPc1FuSal",
-	"BeeqWeD45IetdgH52Q7VrVUr3X4XW0Bmy9DffLkNQPZiFKtNX0uZtyOXBBrrDzcaDiSsuxoN6HLeYWJE",
-	"kkasysls5J2DiWxmAKHLeX+0yxR0q702udcmv1yb1Ce8qklmR7l6RKzTZqNtGf3dyuMDfNtwAvd+7Sfu",
-	"12Y2KyrLeqmAWi6Q6g4YrxNHKmIcdCHFwN2dYVskr8PP6gzs4HP+hoQwt+MsO80PHEoz8t38zMBG907m",
-	"p+5kzk/lY7mZryi5/Xc/Kn+Ib/kP04f22sleO9lrJ3vt5MlrJ24JZ+/XehpSjRQ8vlCkWa8sUHFzaKoI",
-	"FetZPOiieglrZ3Q1JGLAefhNi0qPXYDiO7imPuI83EWUGJh2ytBB4hsYaExdcVwtFWYdoIQuNTMxCCfQ",
-	"LYGwbChsB6UYJ+Omji6zrcgBTsiB/N4lAOjPW2m8kywz1IMPc8CzT/PegvMQ3/BU+RXXLJfPU+YIsBvz",
-	"BIeIpcspicHfonvK5umqMlmtLKk/20EUzBpWGE32xtPA3rt4qoFZbvl2UHSrMhwZXlX6c6y3C8RtRaeG",
-	"BoOGGWa19vztycVtaIFOlbSTSJOThulKssDO6KpYIMBQRbPv5QL47qtbTWt9jRnUwVt7bWtcpRdf/wLX",
-	"L1CXH+8i13mMWbITJXwIrfJqLF3qv6tEtCnJlUU9d6GZG6ifNdS9SWB0L9L3SEVW3FJRJrOUxYrIkNPy",
-	"nn3lC1tV4cqc7iJLHNjf4PpUb3BtQhiJ2VOJ2WIvyn9NUf4xuEZMxFB15KKmql5sJoqq0sMZd83v5AAh",
-	"PTDysOem84/PCpaU9dJlf2bzmpLVYpbfh+UhqquCg8hg6r0rkVsUZO7SRNNIPlnqstVYoDm9IUwqHr/9",
-	"+j8gIv/26/+iJZEdQU6AFablY8Z4YvpyjGwP7CljlW3BUmXHZpiG9d00gE38ca2SUZzwE2KbD1c+H0f1",
-	"c+gsGWs1RjmDndWRXcuzRXJ8yC2+1iHf3v894k9LZ2105ebo+0JsT1C7AkQVltHleTkfy07GeoGKRdl0",
-	"Gc261L2ENpyT2MQALviSqKjWyijOXu6hvT22zfJQHuTDz+pc7xAWsTdjfnXZxz1WlJuGHjJIZoPaKdxj",
-	"WDA3KIzYk7WnUAI4v4D3cU1Gm+5M3NOE74EmfGHp4S9zAtxfPvceqFM9rl9EWXHckQ8jUMxWiM7ys1i6",
-	"lAyuNlbQ5bff4CBQN4LFwHGDOjqH24+fwaI+A+VM6AIADIcoUYsKlw2J1F9Yq7rAiXWzslneOoKe0JG+",
-	"vkqNkyVGZ8KK7eBvtFo1rzZsX/av2jWv1poMG+NOv7fdrW+tkLej66Xs17i7P8vaW9ee2iU/uYicsSq4",
-	"3Ert50O41npRWcvfm++mkM9z9mIcZ99QWtbWUPrsU0NJ9vH0TzFyN7/vJUcspWdCJJbrGux863csFfXE",
-	"EP2hLkRV6PGcfiJBTyn5nUBsDxEtNr/zTEeXfEpDsntPlfblrO2Rupe2zYIBpyzZ1l/ePILmd14t9Jdf",
-	"3gmfzUhMgoa6fArujczuINv5tiwr1GDTVVlFaWHjajhnt4srz6KES5LgA8pmfG+1+35qWYAsnO+xrwiI",
-	"i0bW0dgKj9XOB5NmDmiR96gyzPVZMEHCuivPtuEZe6HPAyK8Qqkj1RspZsljFqBu89LcQm3uGt5KvDcq",
-	"4flX310tjeAxKHbwKNR6yYMsKKCFk62BfaZSm0WArB4MUnErQa7Az3cL9VOEr1y47LJ/1um2r3vt8bv+",
-	"8C/XzX6rPap5tfPO39qt0lNXKbMMx8r9NlqtrDOlD2Z/Tgatxri9odNYp9Z96W6qfr58Q0vcx1rI8gI4",
-	"tv7DvS/NNfeWZvvNmaQft1QsVCU2eSxWuxaF3gtBeyFoLwT92wlBjUFH5TNp5lHKrjZ1d4pyjwcXwaub",
-	"sPUbLSDYBUwSldzj0De3iyfrrS0xx0vK5qkgMU6TxWFAJO4eflb/yhYJ/0jY4Wf5dix/rs+kaKTJgrAE",
-	"olxa8P037XIwU3zwYC3TwVp3g1mzh7sa0mTBY/qL4nXQ1Y4GJgUckh0g+BDdYH3vwVMshXWf8/6lyUt6",
-	"ndQxxQapabKCcvtIHxm4wF+lNemQAuscds/6Q9XMOhQQdLPhLP5Svkq8FAMueZYxpiq9Ym0EOFxNLdtD",
-	"oOxXCvp+vLDth8Ufq++GREyiwCTlZsx/W2G97PLuJl9GWQ7GeteFQOSTH6aC3pBwVTSD21oCN8ltO9es",
-	"TfOwVZjEtiC1DPAeSQpwb5txQFQAYtDrNPU1oNu8eGQeEyHOMAve0SBZONLZ8ScUhChZxDydLyJQznGi",
-	"fGegWPuYKS29jjpQLdjUCaYMXU4jsWNcoUijiMdJa9D6izPOMrtEFOmWAsm2SGXU5zWcnVeM6k9GMeU3",
-	"u/WORsNO/8r0C1XIGU8cnTvLMG7zqd0rrL50BO4dPK+Un8wHii2J2hVQ7+A08lgq8wwrdOx/ZPw2lFiw",
-	"l1yfTiC93M1ROpUbPCVP31q1jZ8MVVo9fK7DVFs4wfcokfWVQFED5bA4zpwV2bj3HT4pNbGkFiqjdKEc",
-	"K7BRChzzloYhBKRwsAQIJZdmmQXaFI5ZYH+vjOcm8F9Hz0IjYxDPffpahhGO8qllA4vkMaMV8xcxZ1or",
-	"2S7SZrGum3z6svcJE98Y5fl9gtU+7MxmA7I/9E/WNiQEiROUCjxXF7dVA9Z3pwtwPT/jKORsTuL1hOH+",
-	"h3l9HALYTCVD2p/Nx+b68jxv5PVLkmCo4bw/50/ZEa4YsVaQnMwYDrh14BdYlK0NWhl9AJ++u/u/AQAA",
-	"///w/haYTKsBAA==",
+	"H4sIAAAAAAAC/+19+XPbyLHwv4JSUmXrhaIOH9n190OKEimbtRLJkJSczdpPBRGghJgE+ABSstbR//71",
+	"MScwIKHDa8vLvFdrChjM9Mz09N09XzZGyXSWxGE8zzbefNlIwwz+ykL64+XODv4zSuI5vMef/mw2iUb+",
+	"PEri7VmanE/C6d/+kyUxvstGl+HUx19/TcPxxpuNv2zrvrf5bbbd46+a4dyPJtnG7e1tbSMIs1EazbBX",
+	"+GzfD7w0/L9FmM034OXLnd1vAMRJ7C/ml0ka/R4GDMXLbwBFJ5l7h8kiFiD8/A1AOEjiMQzCW7G39022",
+	"AnoehVnmQ0OvFc+j+Q1C8+qbYGcbRktjf+INwvQqTL1WmiYpg/PiG4CDUESj0AN8vYI2uEQEzN63WJv3",
+	"4blcln44X6RxGHh+DLB9ipPrWC9VEI79xYTAsjt4G8ZhGo1kS2gqBsemjREiQS+J4nk7HifFr/FpOqUp",
+	"ev55sph788vQa/cAhsDrJencCz/PkgyAOr+hV91e3WvodfFGkwhn7GWX/mTiLbIQW8F/fRrZm+HQmTdP",
+	"gDz5o0t4GWWesa5eFGdzPx6F9Y3aRjQPpwQ2LPYsTOcRk1RfTyJbteBib1txQCPjykWIfWN/FLaDVV+3",
+	"jaa4kkhSoxSo2ZvfrG5qNkwfaxvzm1kIvSbn/wn53E+juM3T2VVv/TT16RjCAh7I0QezcJQVN6YRW8s0",
+	"9W88wMwsyuZeMvamSYoLDYgCfXhqJnWvhYus/vZwsbMsGUX+HLbwOppfer4nB0pS2mXsmjc58yLcSV7C",
+	"DB7ysZ3ceNA0iuVfhAAe7NFVFIQpjACwyJXCnRZvYBzCeoFXiB6MGnrha/gkSk0YNXAZQccNmp2BF/vT",
+	"MFuGJukczshovnqbG7olbIZq0IEBihtxvIAlPweE9q78SRR4/cMDb3d374UHhCOcEFR1T+0m/e1NxTeL",
+	"OAKeLNbd2lCYxsyf44rCEP/7m7/1+87Wzx+f/7Ylfn3Zqb3eu5XPN//x1w2FRdk8jeILhFzsFMLden9P",
+	"yId4IIG4RDHsOB5wudTeVeR715cRHVpj07wRzORcHnDYMWw3C4F+6ZUGDOHjj+8sKBEjYdMQbwkjZkBi",
+	"oHlv4s+REAkyEieS7uS+NlFyMc8Qy8LggojHoy5nZ/+bLWeCrKCz3y5bw84+riHInAD3CE8MrGQGNCQa",
+	"AxNwL6dYSj2mIM7GauIgRJslvI+wojkCapzP+xDMdgCQwyTD1MHF1DvkQAGzmzH8BT/sc/c4mALgHANx",
+	"a/pz30G5TbINrQJoJWkhju7gbcPkUxiv5AECHz6FNzWcGNKXTFL1k07bUxyKpi1Fce/kIJOf4nPvIroK",
+	"87Ro6n8+CuOL+SWs/t5PtBny758c84ePmyakecD30ygcW7Ab7yV/IJHCbCM5ig3P3qvXFjy7r90Auak3",
+	"PkWGiWfORANLhpEDi4MLa3S5mALegMgSkABNBxgOVL6bR0Em6Cq8SNKbIvC9JMsiHF80iX5neIvzgX7D",
+	"eDHFY9buDuGvd63G0fDd2UGj34K/3jaO25238OO03R+eNI7O+vC6PfwVngy6B234/W9+Pzjpn7baR0eN",
+	"zgF+1+oMW/1ho905hl/w90G302kdDNun/G2v322e6D8HrYOTPv9sd5ong2EfesZeoFFj2O524Hd3+K7V",
+	"HxgEQK/DNDmPJqCnDBYzJGO8HELoHfuTLCyqFQFOH8WWce6YI4nMQhCX5oDsiPZwUlJPjkCChaC7aThJ",
+	"sJOg7jV5NPz2w2JvZ3d02DgatOhnoDfuPEkmoR8jxECpMyf+n/ILOJHjxL1bK0ilQGc9RM2iFC76CQjd",
+	"E3jMElBOI8zwuQGMwvq6Z5BPYi2T8MoHLpHEwBii2CON6POcv4UG4xC+elxq+s9kAMCPo4njCPf8FNZi",
+	"jpLmKEnZ2BLAp7itOBegpqTDgBJBIvI89SOWLcMYHt4oOTScj+pFAiyWDVcZ4TGxbp4uCkjXZBIR2eRN",
+	"IBMrM3OWZIFcgBzjRJxzQMDrKJhf9tWm52eNvMWDqcQZ8jH1gRS0p8jGnwNZjKaLqTeJptF800WhYHPn",
+	"IAUki0mAW3l8HqEcHI4ALFb8YChYrRd7G0RksTeTASNLuQhJAQWRIoTVPNArXAT6iNuYQGZuqBqTSXKN",
+	"wqM/WcABfh4n8RauXeCnAdqPNkmrgKdhzYOWdGJhT1J/C/6qeyf08whesGIk0YI1TT++CL3dV96W92LH",
+	"m8Jsax421c10K2iw5b3iVnWvg9rUFDYp817t0pigu1yFBnXtdDtIGI+67+G/J0fDfgN/OskZgBvhqTtg",
+	"DdnCrI1Gr3c2/LXXOhsA4T1qnZ0MWv2NgqECupqETLuwfwvlcH2geXQRs8CDImLo/QeFVNIJadS6d4xg",
+	"lHRBomq+B4J7NpE9ZMbkS4BWj49hQdr81LUicdId44JkvTBFgQ4QyVqU3fz8j/3PhN4xEVFpaQC1l6Vm",
+	"OOwxED8h5ElDgiJTFr6RXD6TlKRI5hBJzaXBTnC5pmr5NoqHIke1HSfESagtPTUn8XkXk+ScJHKhO0aa",
+	"Nuc1eTpUorO6102jiygG6IEydnveBZqFiDnSYhjdXF+i+Ce+I0a5OAfyMTd1j8eh62KMCpKZnEVu3MbW",
+	"v3mIs4/G7w8f6lsIwe7e32/dAwvZl7ayTLYGtPmEC5HpA4CgpIk/xSXELX8G52OBnQKyXES4qYQbtPiX",
+	"yRSNYdJGIXpDJFPSrT/6JHtF/oILDB8IOxqdSEZp1OBCpG8ZiaBkm6RNArwC3CIljzQahAE3EMfXPd5d",
+	"fD/onTTS0eWQnucXBl56eDTTxYhmOwjnHraG0UbzBRCd5+1BY9Nr1S/qNQ8NZpMavJ9K7qrE0EHj7F8/",
+	"vUZZkH+dvX4p/mj0j/EPF4k4ANTHdTuAqSPCgtjn2DrYXnwpea9uS8d4JPoAPVh2l0nDpJch1gGth20F",
+	"OZAMQsBhxsBUQGDAvcxGIB2g1YyaQ3/XwJGAcswvi7IDb5/rHDPNh1NHECWojO3J3bbgRW7mVAqo7SCE",
+	"xvPS7jN6fd8hCGNP0skqa9lJGhVInfq2phchB7SL9DHcR4kQg2FgwNSesaa7+SW+CJOzidF+GaBvw0R1",
+	"jfD6gfVpFcP3y7fbr956B+FkAnuX4SlMGW/EwsL5BtRJ4SdQaOKXwVKLZOgfJIHjkPVhQKQr2AL4WBCi",
+	"tDhM/TEacfRDHl+PfR7iR3rcopQ28tNUmEjkSXyFit3RsOU8cCOYqguBI0AiEhrJ4HXwtu09B5FX9I6g",
+	"QIebCHTH8e7V2003dNPRyGFXQ60MRfdFPE9vxGowX4jD+XWSfsLTeA6EORj5GdI8EGTpLKNRDNYD58BE",
+	"kMXXn3/+eZUwO43LAZGD/gGA5E6V3DxeKIZSbZHrPNlmslvXiUumoBkFR6CzkB7lEtqFT2HETdns7qcX",
+	"i6kWt9iOSBrOFRz9gPmTbchUAhgeEkBh0qlJ7oL/Z5OpErVQpQQJiTSbjKz+s0lygwM6qCzDVQFwAtWU",
+	"4pTig0ALwSd2Amoe4iKlzFkjxYCN9GLJcuoVVL4RwfrF53cZM48oYkk+und81oqvynYbXkVpEpP+eOWn",
+	"Edq32IXzKbxhdQy4XZSqjZdUIIr/w6IILaO57ZbaW9i+ML46xV6ltCHJEqoJZ83WYbvTaqIt6agxPOyC",
+	"ZCAenTV/7TSO2wdnvW5/6HzfGbget3tOOkdQpMsl0dCxNGUCqSmPkjT8wi2K8riD1EFuDhfo/wDBAsfW",
+	"qsk4TaZ6edE7mN8JaeKWIj4hlQ08rHfda13UJeHqh1mySEehMLL8tvux7hkOzXo56LR3DgsXIQpboU0R",
+	"+i5reFbTkvxPt6sdCMYe1my0KjsHagkd9hXLOYnrvZiDJCjWi3gzSoPzJPUvwqJBI7T9rCUWZm1ZFrsn",
+	"DcwoXxgCSByGAa+fcZRs8lr32mNLa2NZgDsEej03oAHFNpyw3gciokkJL/2r0BqZ7AVa/jGHQ6uWkJqX",
+	"jmsSXDlymB94qU/Y9ByWkX+bgy0TA4ssTzh5LaqoKO+Kroyv3JzgifqOBcbnCEOVBTG+opgSCk6R7BtD",
+	"n6KLVd008+2R3HCciQrBcPEuPHSW2VUtnQxgyFAtJy+oF8LKIT3F0yocnaRiZyi2jmVEg+mJBcSBVV/q",
+	"IYoyK9SBHa83wrHL4yIvZcIoQ2eUjQAtSkgqhKBPdP06gkOAShr8mSaLi0sRJcGuXFNGqBS5okKNVnhU",
+	"oynQtWXiC5IoauO0O5mEQrSCaQs/dIi2DLLQowqNMrU2P6F3GsgJin7dnlzXTMxZDMgf04cLQBQfVnAW",
+	"VV6KQ/iOIzuWLgCIId1xW4iCjoXoLKbnACTLlzkRNxMbPPEXMYxK/LNgTi9qHTN05mQYXXaaTEA4dIw6",
+	"RFY+Q6tECuh6Rc0835CXRVwf2b2RbZCFKQQAUx+UJ+RXoQrV8dD1XHXZejngqiISRiLAhvYSOCwOH+Y7",
+	"QmxAZzsk4RLOxMTUHsYw2CI1Te391mDY6A9BADxqH/x61jh63/gVRb7c807r1GlvLorMBqVW+F/Ag/yE",
+	"SillmcyRJ48Fq8BotvijrG+VbG4AT+vzaAKH7gqjRAsgCX+sIKTcMPQA0Mzp2wqi7NOAxSaH6W6KWj4e",
+	"KmymxCvQId6e36CxmuzwIkQB4CaSWe1wjWcX/rJTfNh72wAqJSM+y0YqdnwxW1QWGN7OFpIn5s/J5eIi",
+	"nEmaW6mzd/BFD5fR0ds0nDpDBvQC9xvH7PHDdc0tIaFFcaaAPLCtq8A6BYQh7zevS9l6E3J6p4Amd1/0",
+	"/Nk1ToyCUq2B+yCSMcltfGsPut6L3devt3a9xmR26W/tsa1HOiqlJQomIVkVnl8fkLWoynz8sufW/JoO",
+	"magYJh5dLIRXgWN5ULdmaYpUEJPPHgPFRwdNinJ0QrKNpeNoHZKcW75wG2jZzJtEn0JPkDeQL5C+1aQ1",
+	"Al7GodFFzanK4WOXF31EUykhafSOttokUc3uwS+t/tlB97jXHaBP9ZeT/Va/0xq2BmfHjU77EMg8hpwc",
+	"dU9Aq++0Oarl6PjstHF00nKHkIjY7cwJBL2RmtrIXPv6xmruoeZnjOLCvCb5b1wmVWTthhuuqDKyA+h5",
+	"VA+Btr/tDdooqM4okA3W/oZMV/T9/IbdTZtOvIuyEaJJGLSCi7ADiL3M5gc7HwWobP6eYMQiG5xd8dna",
+	"HKxse8Lbnwu5FtGJmBETySNvB20IPyk62rOFCGUQySvC+kEG73K7+iQ/8L1jw3HWq+OF/02tVPhhHjlE",
+	"J7VSuO4c7nioImykB8sZ0nPSbtLyXoDeMGM3JQsembRMJ+QUJrdjOEbR0Deid1RgD3QTfgaMyO7l98Uo",
+	"uW48uZHxMgWMNGbDA7pPh+2lVhZnM1qQBEmtPaB5YUFhUeMFCMthijGwwqUTB6xzWxFz5twJixXeYfso",
+	"YyIMA80U3SejDIN1wyaVOLyGwyhOgWM5C654noq0gOTd8RECe4NO+AxhMc7CPEHfY2bNOpl97T1aFmf7",
+	"dklwwkTE0YlwDMkxleCf9wX47PEOXGFmx60Dc2GVpfJx4hL0XPu80jKO16YyURyhBxVeOpjaMJoyzbRi",
+	"/CyYIxEHJvA2sZDCFMiQ/m7No2noAhY/6/Zy8sxyE41uqr43t3el/uxCBd1T9DkMOmwlPZDMZbk6rj9o",
+	"B5nuid1td+nK+kL0JQ5GSeDA0oVyfKI7HMz9+SI7iuJP9/GPO1a9ZiGUaxwXmzBxlbNM3ciKWRCS8+cZ",
+	"0D344thNsquhjf4EwwZH04eDk4zHKNA0WI+gGF7kyUsEGxJnakbCRYEeeddRdsmWnKkPcrFWUkhovgon",
+	"+Em23R6cktQoqDIe3yJtq2pkQairGlYEgjzk8KsuHuP8684egQSozh6JCoiNOPBntn6dN0ZNMcKLYy+2",
+	"vG4BJ9Aj7M8IC3DLRUs8XHY2gwjOJSsuiayYOIfNMhVsLgK5WBCLUg4Yk/EomhmSMoJ+Fha9jU+FSZWC",
+	"PXLdYCgYgPHcwEk1BX5Xw9jZLRHMtumdK/+OGUBdmJdPxkPHWaGp1b1GPMLAuoctnlo3VAyMaDs1bXQj",
+	"5j+KYmtVaRWEr85UKvG5iK3BwFQJrlNZXOphd58cN2HMoZ+TjLNN+uHxnmS2JNP4XSM+2Q6fCYt6Ltiz",
+	"KCPhEVsZt2lA8ziRm3lKUUrfZUDOiuUaY39s2pBfCNCX0/BiNPdSdW3iX4HetdLUu5RyGzGZj2+UHTOE",
+	"FYi/aljVpFrNOvsdG1EH0e/hfQ2pJbPVhtSypdPSxpXYtArdCnP5KoDdpvWKQzBehUF3gKjoir6F48Rn",
+	"kI8QRSTeZLgXStzCJCFGJNvQRmH7YggvJB9G/13ryAPxd/G55p2cwwwW3u5P9Z2X3tFwUPOOB977KA6S",
+	"68zb29nd8/p7lUUtnsDKs3sPK/Zqg7U+brWc8bqwvgV7Nu2uvddOjmKe6ELaqEEWrVxYnSkMK3ZOzIOt",
+	"I8LJpS1Ibq7wf0HsJI9m6K0rKyiaL4KwdpTEF/QL4ygDAGTqT0B88tnVtSBby0tAX2iEds9RlBWjHbb+",
+	"9vEfv334EHz8slvbu30OHEX+9fJ28x+1Dx+y/7HavCi0cTIeSXMKJBwI13GJr+Vt78TjPbPog5P4HYNk",
+	"WxIjAm84FKSY3nYVAu6nQ0pE8XEUIPSBMDng8SHy3zltN9uNmjc1OxK2Ws5jHIbZxPeOX+9wLmPNeny6",
+	"uyOeS9N+YXEA/lMFiXsdGFIenSCTUDWOm3nnJLQ/6/W70KDVP+N2mKNqPoWvEOvDz/50hlEpG52rKIh8",
+	"F3DQ6dvltPatxRXLzqw9SXvTagYaqBFdp1JxmwIexQSOw5CUzOEQxApY5HDeTPimHSEEpfQfn8oOsJWx",
+	"4HvH+/DXS/rv7tv91c5yNUpNwu2arHSaL7MYnhSkWimmugy6pq21kNJlKwSorWhqhur+4xgH5aTQLuMK",
+	"3F/EMXE8Mtu4ADML2sgN6LU6TU6w7rcaTUyQPmy0jyjuddjqH7c7jSG+/uiEJxfYU8As1cJNX9Rrr0Ph",
+	"ZjLlVNhd7TzWS1+GtsBC+hjawmxDCc/kdqTCMyJtz7HqH8USn5Gs/6LEP4p+x57K87bT3kE6ECciV33I",
+	"cIcCuiBNVEGwvhfcAPkRRTAs4zlWQHCkDqOSJCNl6Bs0ScuMHyPpw1L0c4MYkTkItjMQSOUDvH716sWr",
+	"VakJtC5pMk9GycQVtqpLi7R7nCBMoFjBa+hsoA48diYOD3ogXTV76FB8Nxz2DNSEV5jJ2sT/4qsz/I/b",
+	"u3rfQkpARBh9liuTUp23I0/dyJlT9XQ5pF2FqvNLrnKhY/coslJE4NKAxcGRrBhCrK6ooccWGOlOKc0d",
+	"LSJvuDuFz3P0607n6x5UDbMF2W7qZuESra4vQwFBaEw+yhTYMIlkQUmISTqROe4Cy2SUZc0sF0Vxy3Nh",
+	"7MpCOjMfNmTTDxs13qhonhtFhWwaGJDx+lxHGCpofaCLUzmtRxpDCV9AWqMmo0mygI18jwC4hqY5Ylqg",
+	"fmQdf66uxbHvKImdHChawYW1ZjOdWKpIjU0xjKN42h6099tYl+Os9S/gCx2qnmE8bXfE05UM3C5WZpEU",
+	"g/IW8MLN5a1T74jI7UnamaOxYikULSqj5Z5preKqMCI0ulhozktsQ6CYtLB5oe2VBA48ftppGz62B7Ed",
+	"kw+T4sKLDHkmHxe+S0NflA9cJX9hD87dmF29bAQBJ/gp6Xj355/qr3brIMnXd+0JPn/+G87jv7/tYs4K",
+	"/dylf/j3HvzzUv5+Bf+++rgJ6tLmF1Cb7vyhe6lmV68lwHA+u8AyfvtiQ/jmv893/vHf5ziSvzWmTvFf",
+	"lB1uNzc332w+X/b+zSZu2e2qXv4KwOTGff7b/775+Df4/u+3/HNz87/66f/wo3+8eVN4RL1Z6snezs7u",
+	"m+D8pzc/vfJfvHnzk78Xvnnx9503f3/x4qVrVY45EdIABxTULyU5RMexq/Ferax53j9SQFCRhrnUyQJN",
+	"OE+yuqkN4VxubnFlKAo7jSN5Vke9mnljn2VYK/ywdUT1prAkASbvR+cLaZ9QIcbvWkhiT/ZPOsMTqm/U",
+	"b3Uxxviw1ez2USt93+40u+8HsnKRUy4CEhXGmQVkd3B21D5odQYtroxx2G9hiFv+cbdz1mwdNzqoCHS6",
+	"w7NBr3XQPmy3ms5xjHJDxjinrf6gDR3xJM729nZenh0NBzyafIkTPfvJ8ezv9rNma7/d6Jzt7tqPeV3O",
+	"BsPG/pGYx/HgTKzNGVrmzvp7S9aoUAnO2Nrc7pglj+TCughhl371w5lleMoT4iyDQxC4agrxG9JuRG00",
+	"LP4A3WXRnNV8B/WeJSf9o0rxACK93l28QFfy4qoRdwECmZ9b0cPSKiTSVu/OdR7L8gBKyrIeqxBbWdqm",
+	"/75QyOaUq/yge7H/Ht2WfrB9nQIKbJLc0O/ysy2UmzcnZg4A9tXvulM6ZZqEFmmXVgvD9A1ZMGxupVlI",
+	"W3nCQXMxBgOzPCOCRrkWERklpT92rOW4TMbUun0v2aWPIJsJEnKpWv86ODoZtE9bhRVrKZ8OyJUD6AE1",
+	"DpCkM/ophWahKGFBWFW1R0NFtS5ECgllGYH6wd8DfqSJwA/9gWmxMCEbvGv0y0gS9X6Mnoeej+VGlumr",
+	"7KCQGa9UFoU+3yjt2I3o75YU51vVo9t2lgnbme5BxKiDLMpBubs7b/dr3h7999WO93Yf9wXkrbf7m8aq",
+	"QasI/tzjf17xP9gqWk0UDfiK62otiIsc9gDfXAJ6t6dSNY0qb3K1ZvCVp3BaPm0PTs2yYcVcxG7vccRo",
+	"BLrEaDU4VXCbarp0TxDkdXefbFJSRpYdl5ElV5e76Lj1F8zQi6IEfeJ8FRlaQXWpydIlVnmr5tF8Err1",
+	"hwJKyCykPu0v8Zum8MOVxDPCAkQJ4wEbQXQ8sU5qVOhCJI8S9dAuFifXXEJuaTkXSrZq+jdL8+0CeJ8b",
+	"rLLbtTs+Bnp2ubT/KbW4/wi/hn66dIAbbHCP/l27mI+GQ8Yb3wg1yvI5om/uIyo5liFA6ItYWzhzvn5t",
+	"vv6Y37Cx8PgtjRnANoj/1liV8V9qtCszVi1Y79D960rdy5qjS3MksU1BWceHLprMoZ2muG5Y/Y97oGD0",
+	"fz3DRydUpLVxCj+FgH2EyTFSK9AvXCwYxU0XNaI0rSVIegXvKU/g+hJElZryx7IbdgryQoRNyJin/vIY",
+	"h0lSkHoXDkGf4s8rLhexU39F6y0+M97gFRRE52hY48Xe7W0tp+P+7Tn6blHZRT8uKOfTzRJOchqlc8pD",
+	"dps3ZaVYaZOVsVAHyhTHkUww1dNj8fv5Pw+67/dqXve0YbJ4egp/c/oS6jynDee+mKGeRa9ymFyk/uzy",
+	"xmixtD5FhF8ifOh4QqGNMnykKY1qoHv2J2bCOYYdUuFbChe68FLgA5Oat0jP/biGYvEC4YbNR0+tCE00",
+	"ikSokuYUh5irjSxjp4pe4zC5Z+Wux83KMeGoFVfedXJzXVeSQIWE8nieSASiL2r+hYE77DuXm/zPRTL3",
+	"j7AYrAOjjv3P3v9hA9RzNGc33K+Yw3GdcWCgYvNWREjlqhmOIhFLqa+ImMkGMj6mMoGXwXcr8/3tSjiO",
+	"QxmETCWCTvtgGeVUsYfKQTIiVyPLTKIUsOoME/eMAjlWwfMEvqLzlXC6pug5U2eQ4j3wCoB0vkBfCAyC",
+	"tekAfTPoZOIBpBUDu8ILZJz7oNa+xzrCbvQIJrIIxYwoiD9nuzp7SzCrBwPxSTmwacLx+Syr3ynCrNlr",
+	"/uIg1MJXRJk1omXmYVtRKEzX56m7lW3+ZgCC7FW17r1Bv909VUtfXrY5X40ot6A1G39yoNjzdtEceeBy",
+	"p8dxkNUrHfk5uSmqcTLhKEmFwvbVDu/j0uvShagtI3cuElJG2vtGNdelKWBo4prNSxJPKid82GkUS1eS",
+	"Ygzxm04CX9w3+6gcbOcI1ZZpWfaRHJC/sotfVF6mHK9b6UlwzbgAQHFudNEL138dUPlXAi5Bu+we1yfN",
+	"5ZDJ76nCasFQ120sLbxq3LxBJcNEzCxxYOW5DCmkNhTFZ4mOIiPWJWbzEKnKrRvbDNY2W5vx7q9ERO2O",
+	"w2BrejFFYnzAua7sk45RuM0lK6p4eS0MbPCx3spu0Pu0MVic46zP2bqMldHgObCKGC1nuSiu3yWqiaph",
+	"EowTyjyowcxBxgmvGKA0nCZXusIYV59E+Zx6mM22kvg8waLwWJt/o8t/mFMx4wPEJ5PRlCRoLHxQ0lSX",
+	"8mGKQxgjAWWLiREMWygPri6/Qs0AmONWIHPvcGDxO78uxEVjSnGCMTnzZQu3z65pbNegVmXYb+l/NRU6",
+	"0Uxc14a9HRw3al5r+/1+22v02iA67NZfkFN4QfhyOZ/P3mxvX19f1z/D/+pwFtl05boc7i8Y/pwmAdei",
+	"+RBvbW19iLH8DqZbU+9ccXPCFfpFBB+ZmJVawkEWpiWJ7t6YMVlCpYZOY8iRGxz/otdMCKM0n/oHCcJf",
+	"cHBvgKiunhE4hkRCfF64kqRsLsNtrDsb1N0qHEaSiLrPOCdZoTd78yH+H+/sTOcCHRNCYeWCszNvyyse",
+	"MFW42zhlVJQD0fQymsm72PRUeYg8yUYiNlBHjT6nETUAnJsoV5dLSVonURQypHjFxQyTjTMxljhzucno",
+	"Y4onM3dcuQPn1T4JFg0ZXfqpOMQzf/SJynnpLVRRecWJY8LRAwHBePY0qjqgEVvSVSQmB4FkSXrsmhrY",
+	"oF1WhNIdx9ZlYpw4JcfLzR5gmqJsV0LTKkMhb4yRPDMHA1qwopG2uqfalMwkMXZSRXN4bJbHSB5elicZ",
+	"sH+MBsRnnqKlXOwsWBCXFNMwyz+YpAF7PNrv9pl02iXiqetTqxqEWX7ft+vJkzlblPc3SA55siLaZPFs",
+	"aBbYVJXsAt2O6A7tVDJJLhAzMfSKggMpJSOV0WGkckmjDdI2gw5nRPdov4SQ45EsR5OS6XD5FbYyn+00",
+	"QQxFpKScdMrDY2l8KocoUlgtfBYjy0RKGtSo36cI+Y2+QMR33A76PJtHVPPUlQy7yUvuxuRUBFzjEotx",
+	"aYb1wknyJDITkLm701RCd83TAoUHG7iNDKcQMEjRmbNc8Sed6fr8uHWwWbepKI3aVLRQkUI6JjeKsxFN",
+	"dF/lYRSPLTID2nQag3afOe/M3AmhnWsWTD3buy865iJFgsSNw1RnLaNQOtPFoqZcEn12SRfxzNHaNa95",
+	"7e7Q+xTBj0Z/+7QvQ/HIdtgu1PHgkpv6Ylgjy1deQ+hGuqZkoZrt8s4aD4CBz69DDKG4TnAVNN40iE7u",
+	"19jCjWeBpZF8nhMnWbNX/PzG20e4QILChGn0+BNm4l0h3n5dky0zMJFhsvmhsvYA9iIu4TpoYcjoR5Vs",
+	"8ISfJ3/AxMWPVkUlOmkTvPlmmuDxBzKBhnsc4LmMb9WYwddCORKqZaFhSkfPEV45rGHlAbKBoeHCDoNE",
+	"KlQVn3LBm2ij2pLfHf6z2cFD0JbIRxSbqb0P4F9jqZtzvLDbiNLMy1sCVQ35yQc6eoHpV+dCULy+BIS6",
+	"SENGLT4K6irbgtAp5DynTE+t5YUsRMT1MHnAVBZ4fuPk9NmeaybApCGFkcRzUeEN1GgfdqxEUCwsheHH",
+	"pzHbRsJMkVLZHcsKRVrkEoA34nvBbCEUeU1Udvn5TZl0IAZna4040VTByshDrOkbF6wq3PLQqjugzlUJ",
+	"J75x7SIFpGZs9b1M3J8F7NgaNLeCd5w8BrGbkEroEFKjfLgClod+xwIFC7Ycsu4qxWVXm6IbhoiMa3bu",
+	"5GrEZkx1UVzKLC0qdQMIUR9hOStn/cWlierqxVKwXMW35VVJJt+WaVYF6inr2xrf+xyuL2S8g+O70Eqk",
+	"fZNoDJT3ZjQJhXYm1aYC46l72P3XJH5drmRjiHBudp5j5YJc5So+MDQiam2WRihqIAWk+VFNR/T75Wod",
+	"CjiUEMSCPl8BxmXv7WodCXEvSVDE14zHhRIgsnPxPHfOBstPFsmq1zH7WMwx616XHusLafC+LMBrmHIG",
+	"B9D3hgNKbaLi3yxoJemFLxVmAZS1dgTQUBwwjqWjel2qanWuUpy02JXVZUPZw0KX4nYxGBpf7SVUVzbz",
+	"Saf95/vYiTUfkBXFxcJ4voV++N5oUzuSOh5rlysvPJBeP/tjD2O2VhHt8s7cRdUBCbbSgs0tifNqTU2I",
+	"kLbwpmMyY9K2iGNLZ4AA3zbDP4TlSf8xud+QuIBihz/p0iau4UNkxTZ4aUEk1RUNNWjeAStkWtbpSc3X",
+	"uDc8p8iBKhEn1xOuOmR6cZX731KYMGNKl7wCgi6WBsOGPXFHpdApLy5BqMb/Kg3ZtHDI60fKpHd7k1mG",
+	"QjmY907yp0SqglIfeH7SYs2CHxSVi03Ugy0LYFca06VG/iEuM9LxXNmmkhMr+SHKC/c12r0N57pPEVcg",
+	"JFa22WS5ey9yFKY4hk1a6kSpBFsLc/UkqRiA7pb3WhRMy6VY10w0Ma+5ympWvAf3FtoqAaKxyR1R3ZPa",
+	"JGiFYdkKkImMyotSrOBSpwP3R56pG93fCRstebOoKijerBhjKwPLrLqdbIkWprr8ueGrR0AnBw095hIp",
+	"LvgcUjhDktdHARMXRl5bjkm7uUXNgE+8kbWbDduAIPEO6BjXK1mLNZlRThxWHGLmE+aaoRSSW64oo+Bx",
+	"kTVtJBboyx60mdnoi7FUknWpdxtCcs69wuTBlBER5JM4uw/QFO4eJ0CdYYNTN9Q55lJXZ5kit/y5v/II",
+	"86R0wdnixPJiHRrfLEe9H5fAwYeApEPPuKvwcQ8BHwBVLVjDrWC0DGECPMI8h+9AnhHUAC2rGD/KcqXm",
+	"xJVCBptPSuRJJiJWl/woI2lV9kS2mlV9wf7mOnLv75075kmiTg39nshrSxzeCdiT0r7M+YqujLm6elsO",
+	"G/d3GoXXRm/uCase87bJnEKwakDCjlW+FUsJyRvTWBnJ8gNLcKkghckc9001SLaSQlbNKxKlIPHOk+TT",
+	"pzDEqtk17d8wKkbjeRY2W5BOjSL6iSF0aJ6Qn4PgWUW5zDhy+Qx10y5p3ThW8/7ZHXji4pGauUHa94ay",
+	"qOZnRhMdbSG5tXPRzQNVttuSQSM+Fb+XaOXaM2c3YvtbgrtBj53wWivGx1SV1QZHl02jW9OuPT8IyNcC",
+	"ErjhWjpKRp9OYtB+PxlgKgP6YZKeR2QtmqFzZm7bQqk6W94VgH0XUNuTRgwQmcchGxiKSE6NJhP4TjAw",
+	"dcFbYbEtYltgS+LKdBzQLWPmelPVc0o2wSauAryhdiyW9LYCSJqpuk5nOYklj5ByWRZL6Th8P4YZSe3H",
+	"MiemKferG3xAhZWQEZvLDM73fJkFctNzT9+jIsHa3GZfEZ4WR1myiEw5oEMATinbkpw4ODRfqUKZXGxz",
+	"hU/1Mc31o7eikE2le8x1wwiS64hl/qrd8FYVHL7QPyHNgbyFQh/++zqC89K3tNnYMpHUnXxVy7DkIoyS",
+	"MyBDR8RNGHKC5f5nmqkZ42N44IRjWiymckajIRZJkW2DzTun4f8WOzv+z97ezt6e93Zw7DUE6WdbXwNl",
+	"cFTlNcbA0w2VSWYUGDdjSoSF1cjGfrOxW9+p71A17lkY+7MIHr2ARy842J1TrrbFOlOIYJLNOZdOXMJO",
+	"cXZxz6x73roKOafpy1/FTtXPk+DmL9uF6ui3Zpei7T40FbfPzEU/xnZt/0fU2uAgQ0fUYhA46s3esZA1",
+	"dOIquHDXAtbQzY9f0dwuUn81+i4K1OuedL7WUmC4lSht/6BJTJNAaW/uayaaMtQIr4dw3DJh9uC6aMKK",
+	"56x44QTFyeZvaz5sNVv9xrDd7cA3/+52WlhgotV82zprtgcH3dNW/9ezQat/2j6g9LWDY+Ov4+5++6h1",
+	"1mkN33f7v5wddJv09WH7X61m7qkrkUoJ3HmYBsPG8AR7Ouk1G0NKoWs2qcbeMQDk7CsVmtVDTz338xgH",
+	"n3t6+NnXxg5NBch3dKeTfIdQdYRJn5iSm5qyOx6px80kEKPf8fqlfC1fZ8V345TkMdRxrh1R6Le1Yqb+",
+	"IpOFrCTPxItPcSdjtgaVaJM5XzJPu2bRCaDQdGkBKorCNkxJMEIir3sNDHhldVHWBSYmoSfKKrE5VfRm",
+	"fIhBoVLEAbV3OpVvPN5Uw97GlilRLe9Znuw+q2NPRFmqd6Jx0P4c6MAb9LKbbSWHfcbckO/hDgLSIoXq",
+	"qDtgElLowzisZjciDlQqJiC9OgkjyvFEqQr9ljHGZ+Ydwwio9DoTsAaVLe/aZlLLO3QR6vLVzFMzc0Ww",
+	"axE7ZvkZ6kuGWbrmzsHEupcO5OAw5dPJUfn8bPgmgapjLJ2LayQxFfcoIj2MvD9ETPd2Xjpq8X2esfdK",
+	"OYq44re+IW2k7MYiykJwxZc7O2UkVw27jY2o7W6Vtrvc9mWVti+57c9V2v5Mbff2KrSFRrdYhKXC3F7x",
+	"3F7tvKjS9gW13avS794O1wwRBX9WtZcNZa6HorbIEzfy3suN2r0VoWpyspkbd2vzRbxGroiVO18FCCPz",
+	"zME3D20ut0X+UHWtnwiZhqW6DH1K2IGBOZB6qxXDAaO8okKRLrtBTdhZzznIoN0aHnr9wwPv769/fkk5",
+	"V3JGxaos4t7RJWPlW5QOtvdid8Vg5SX4RaEDUQ6539Zlb827DKUhp4brlqS6UnLo8Y3UizR8430BmaSf",
+	"JPPbbSmFSiF0WzP17atdqcNvf3GIULcbeWxaNrXbNZH6HokUJjVPpz4lvckoChIqKY6C3Ac5d3+5Ew1V",
+	"Uf8iI13TEcGBIrRM26TSN6UJm7/pzMePtx8RSAsB1X2qt9s+G6rlI5ltK41MNvFtOBrXNrTbnoCK8KzN",
+	"uGBXTIWtNlxDL8X9iiYM3dktVu15JIsYTHKl9oUrYamJvjb4V6kMXrg0INeFSEVcVUqKffokvMpPlkJN",
+	"zXuquT2oqvZfBXRuTPcHZZnzmnsya1ohNYDqY1C0MKBLfFWvVo7xnjYiZarmejF5O5HhBapsGHoU3Zwx",
+	"LI8zNa2z5zGhiurcMaMgZv4N+t7r1YTmhsu8z2tmxVYYwXbBWmT+XrlRRQYhc8RX8wc2LlTmEI7max4h",
+	"ri7DlSnUhLC7Fpl5A6utu56oEepgnGAeRVTPEU1U1DjF8xWL8ETzLJyMvWI2q0UOFpnzOpduZ7/b6Dep",
+	"VFuzRb/lPS9oAGjqm14+fi2KqihncfUeZviUpDpb1uFXI8ZWwvqa5P4IJNeo5LGK8orIuzuR3/Jvfjwa",
+	"LOZagRDrllg8nE6T9tqspK0yArIqYaUIBvmRTVnV0wpktdXvd/vGbVknnV863fedKpXl1XRzs/3apMte",
+	"qTXd+lHollXDaBXZclbvKiFXxbY/HJl6vCCN+0lK/F0/zE5mQUH0XGlfkNEzmCr3lKrxKcA74dyCe9WM",
+	"8/Uv/4xVJb9BUclifbqC0H6PAAWlFuSOwJ35IAcaqBhP30g5cvHGneICUQ4LuWXWJpUflU/qWoEreCSm",
+	"MNxFrD90t//xeCUV41gdijYJ7yfKU8nzu4jxXCNdi/DiwdeU3sUa/LGS+6FemLXU/meU2guh5uXEqFdo",
+	"+uOZFtZB8eug+HVQ/Doofh0Uvw6KXwfFr4Pi10Hx66D4dVD8WgF8Ugpg1RDQtHhHZjWzVH/Fhz9i2I+s",
+	"s1Ap/Mdo/KiaiSjwKiq5LhOzxrLYa8UbxLh/EKPljWKOKPoFFw4VXVe809MSnNRY1lB3lMbwtkp53+/y",
+	"+yqp1WPJkCs8DTaKKBiLe/aVLXpcLCPw+q5rC9aWvh+K0NtX97iovco42TbJHV0RVFqjwoz0XlKkwpFA",
+	"8DXKVDy5oPzejx6U/3js7AcL73dziHXQ/5pT/IF5srri2l3FcANnHyyF61P+neRn5RWblbdKPhYTeYDe",
+	"AF+fygJU+VMsXnBkSbF6XCmNE3zpMZSFlCuilQeFcUm0eYL+dSrPmr9IMFd6MNahRHUuDObRdVHR2Gu3",
+	"0FTC3QJyZYspDfbMo0uo6OaIZ/0Wmr5azTP4cTZ41zg6eoZMzn7e67cOW/1nj3JHtQMgXgxxkDeskRun",
+	"3XazcIPmQNgxM3k7u1UXkyut6WUK8VoW8uLoovE6c1kEWlEJcl1fHjsxa8yLcnm6cr3xfcz3dPIOOBcU",
+	"YcFkaFlfNwt5j/gSBgdQWd29A7on6GYcpXi1QHqzbHo1XKIYTdTajXmREFLB0woj0w7YU8BbjsQ03EsK",
+	"Q4JAS9dp1LCIfISl0keXSUSlJWdhTHniixnuVpSOFlNZ0dEe+rDb37/r2BT9Jz1bxb0gt1ZhXfNPJdY5",
+	"oPkDsnI0YXFeRZtPuDHFI0NssvRqgygaJM1N56tIUk27KqdZbjUO7HqITsHpUkYQyXqIGMkJ+pn2KBhG",
+	"Viw+oF12hdK8iAqiYrUsnynAMtw6uSAkl9S3V0Xqk2S3UC1iLet97+UG7lxG16gvsOL2zbuUGliWSVpq",
+	"eMC/t7/Q6aZ38nDTM33SyZRwETqMz8U6u9+JoFtzjivp2P1Gyomz5WNYNPLeY5nyJwvuD6hwU2KZdhhW",
+	"lrc0FuChlpz7GFXcfMoYwjHCnS3aj8t2V1sUloc7FJiks+53oGO81vziu+YXRpV0kDLchc3HdmTYd8ku",
+	"JLT8QB32223E/O0vjP+3LPxghW6X51LUe1/zjJy8fb+xXBS3ZEBFne43UoH2faySfeM0dsr7w9fS75Ok",
+	"Zve8peHrE7VaucBauL1iTX9+UPpTWTz+7r2JtxWsJ40lV66sSelTEgzveH3O9yIiGmVVyiMaijdyrY5t",
+	"KKZdfjfRDY/ngF8XpnpyhamWBxmsy1Wt6f4dggWKdxD+gEEDB3JQ9LVmFaht7gOWpDRrK8nMsh1J+Svb",
+	"hA8p7+HV7qOpHxiOzf/nMbUE2gKv6Y52nfFC97tq9ynf404ZA0jERvOlPvbJjbwsEm//kvfXfq2UsHvH",
+	"bBzDalLR89XfqqaPEXDxz2Qgrpqs8LXRmL9213ZYGWtSXiY27/pUc80DW3NgehlID3OLrris07h8Uyai",
+	"4xWeyQAjEqiKAvpV8bZATCcrelbZE1o4EHTj9sbqIvwrXJ+CG5OXfW34eWLaylO5GrdEOXJdBnxX5WhF",
+	"FclVOpJpTV9mJucLLv9cZvKq9mSPFo1IlcqjWxOP7554iJufs4IIRpYNP76RKfXRGP+q0UEPjIteYev/",
+	"uINdYkrO3T29Pp1/Lq2gvlYKnpZSUF2wzyPS3aX9j3c016+t9E/QSu8Qe3OW+T+QRQG9H10WmRRnoP6J",
+	"2dT97FYr/YEunpU73rZiNPVvQFuPM1EyaJoQq8GSs3HoqcnUvRbG/Ku/KcRdK/F0c5XvyYHIIB9Q1+Hn",
+	"WYLlaVAly7iUFZYBxqQKUKZu0HaPieD8l52UkGGdGGk4CMxL6QPb7jDB6rlhZoALEho8iVITRg1cxsmC",
+	"1KDZGXi4yTYfA9muOyYkNCmzgL8DzTv7gN610tet98XXRuH1j4gCj1OvXjXoEKYWigsvYFvPMb6ctFO6",
+	"Jm93d++FB6JCOKGZ1z2FMfS3NxXfLOIIkFTsbZxLHHp4Yoy9XPeDnCSdQPiVUDKR2+ldRb4RRK/xVmTL",
+	"sN8csALbzULMgFErDVhIb+mdBSViPWwax08D1lGV5LrXk8W02ECAKRuM9rmvTbRfzDPEZEycrD/2cgJy",
+	"fqvlxEpEXme/XbaGnX1cQylusuFFiKejkuUUS6nH5MHM1cRBKCtMwvsIK5qTyuxzdufQXTg8wPFsEdFN",
+	"ZNDRGY9uMF1snvpY8a9ASc6BfF1jMfG+fFhoQrWNgGUPuNi2owFIENEJ7Axz8sxBr4T8iVk0gp3nJcWe",
+	"YtiwPSlLKOaVmEDfKLMaPbEjNSEkzmEMD28UIRd2W3c9FQmAmTXH7NflXybVfEV6XGmt8ZpjbV3p5oBu",
+	"qR9nY0B29YFp4vaeAzZE08UUVKVpNN9UNe4NyCgHSChxVMk9QiIfjlwlU0RvJmoZld0LKOMqCchtLDu8",
+	"GypZ0Q3oBIgo3nNMm8O1C1Cs+z0MNoktY4pdzYOWxEthT1J/C/6qeyf08whesGQh0YJMrym59XdfeVve",
+	"ix1vCrOtedhUN9OtoMGW94pb1b0OiiNT2KTMe7VLYwLzvwoNz36n26Eakt33WNrxaNhv4E+X7z5/OEzE",
+	"GoMsW8Csdsxl/zO2+9haMso1ID7No6tQZOqlnhyBABUYmIaTZMTJX00eDb/9sNjb2R0dNo4GLfoZOLGy",
+	"cFoL+zuAuU1CHn1ONfbMJLUUZaYsuohZjuIcz/8gSyApjzqte8c4SkkXxBjyPRBYs4nswYyzaPR6Z8Nf",
+	"e62zQbvz9qh1djKgtEP1+Bh2qM1PXVsUJ90xzjfrhamIi7S2abdWvEWBzltMic4CHr5JAdcfqE8cchqp",
+	"bwe0E9+wDgBxQV19jfjVJLzy0XyPRpB8gjJ2gss1VcvnLnK08haDr+tgI1z8Z1e72Ghx4jDko3kuswqD",
+	"R/CgifzEtfvsyZkRWC/PykqGZvmwrRV4iPgmRJ6aWxlDZmxEhOkmGugn4Snb9oMgwvf+RFl53YXxBOCt",
+	"zxEV0YYpdcJradBb20Cqpww+mrV5FeFVtV6rhyI8WhQCitNrOvrk6Kg45BSHEIqTbuENBSJIxCIjSxSv",
+	"jp3+3ohe5Zw64Vn9cxp8v1WuyQoKE/CerMnLEw5UyGmjOT8Pc62nQ0oOk/Q8CspFp6Nk9OkkBj36kzER",
+	"PAhryelOktOS6yJK69jIQAAl8Ixps4KQqkT6bDUyqkMKrbas6A/vdMEPMlYYkCtlhtYo7xku3DMOY+CW",
+	"lUuFdRLitrbq76ykJWr02N87zTL3TAJZLYyuEh1XJDHz0mzPMAd2vibvT4+8Mx3cpkO1HMN1MOvvTAa/",
+	"OaUX5qZyIn4ywwwi6cZ8CrkkbOeEmW2jZX4rENFFX6cQvFzApnm886d9P43CsWc8U/RMWvvErU4m8kjH",
+	"OjoU/M9HYXyB99HuvXrtMMDmoUhSeY2SDQjd55KMTYPODIvUUR1hCyJHxcEwohhpUQNN/OV778LJFG1C",
+	"6ZyvVRyGQBnZI0hjkMkIdsePEO/RfWgWwXvXOjqGP4etfr9x2O3j70Zn0N4/Qu/AAN5iUbyD7nGv22l1",
+	"hoNe68BpgZZgHwpvnT1tAtFPR5fRVbit4ZNPbOj4XkgAej+K4Xx70RQOYTF8omhY49AL0yF0Tj1srID3",
+	"UDQvu4I98ybRp9BYZ7p+N7/MGXlw0EBMwKTs4WVQzAV/3+78u40hRcNGnxb+X0P+4+2/V66sO2oBn6pb",
+	"y6BV3cbYF3vEQOWfPy0Z467hFPI7CVeVL6mt8W0/nCVHiVByV/TRJSHJ+sLoSdQwdKfTyuG9K6PKq6jb",
+	"vQxBTqO0cE3b6TF5ZehgdIaNdqfV5weu/RuZAUarglmFfbhmXCBNjogMKAWegoI/MrTjjIpiWo6OCA+q",
+	"rKNI8a26fjliL3usDAewHcNQ99pjTTZB3jsPEUh5ybY/N6CZ4G2ILBUC8zTDcC/9q9AambxrvAb54VDS",
+	"zEKbXLvGNcmBHDnMD7w0JsqMarnLhep6e1cFNqSAum7OoPATa8gjAcSmWQT7fuNNErmXvZP9o/ZBv9Xr",
+	"4hJQAWCsMrU4h5l7J/0jQareRvN3i/MakyzsqOYFoAXC/mOSWIZFYTGGoOb1+u3TBtB+s8MxMaXoil1S",
+	"M7zmEsWgwMUj1X2XNe+kd9RtNLEX6SenbaOQarlv23zlL6Y1o1CDg6EGftw68K7Dc4po8Sd1Th7i7VqI",
+	"nWEmQK3J8fsTQnaSTp7Bm2kYIJ5Objx/PKc5jsLoigIrLg2vGgexxeh/Drh0KL9m2dJGNOq9f5R3yPv5",
+	"ZaxRfVHx8GrPu5zPZ/I2uUBEdcAUTPpvLDk81RtKNyziEq6+1tgg147AZ5POuomjg7qVijB5AlYpJDou",
+	"KIpmzKRyg5sxZIVIRqEiGxeEZSGKvnNNBtwkkJDvRlRD0AVjAYCEMs4sJ6+DbFRx6O4sOcAKt3Uu0+Rm",
+	"rUU+AV8u7luWCwWRVNnyNngNgxH53u/RjGm2EGSR9EihEMjONhJUoDf+Bb7AhoJMaxESWhCt5qDBfCAv",
+	"m1jyoRi2BGx5QAR099RfV951XVRft79omlSlWOD3pc2W2CFNKntPY6QhLVdMhpSItc6IfJppJYje7GeQ",
+	"O7mszNMjH9Ql1fLWJ+4REhzXdqofyU61tvus7T5ru8/a7rO2+6ztPmu7T04kqGTn0crKOu/9qeW95yih",
+	"PqzfxpKAqZ1xAvgFghbGOuHfzSgbYYrkTbl3HFSbAzjhZNf7noLCv/0NjQ/QVay1B/mTl3J1Ov2kojjH",
+	"yKJFudsKF5nli3u47z+7vX2gyrcMbLkkYdCCBeogsrqooowPG0m8FGGF3wtx/EoE74GECZfUCyTSobw2",
+	"CoNFipnVyTXFbhpxmpQ33dp+j5nT3TS6oJsngO/DK8n2jdZGKQSfivE4dkfG8ZUURrZj3UAeM/J9sD2a",
+	"fOjSTOh5TAcGwQGkwF/+s4yTACeYU5cb2KS2Eq0GTAPvQmrxyG6p5VtBa8kEvSri6JCL4qyjjUzKCM9H",
+	"n7LF1FExoPnKk29JEj49JtET13rrnKomkiyb1TAkdO/Va0D2C0TUsWn2yFwq6dgZU/M1o2NwxDsaqrQO",
+	"J0T2okFid++nlRYJ/KxC6XVuJdpXsUIoK4r45uHWB+xFqnTLvkZRu41LQo3Fh0tNFghrVXNFNL1ox9kA",
+	"DbxcN2gp3+2dYDMJCHzbHVSZgmi11mPXeuyj6LHikBd1WHWai6fEOHAm5uZPgFttvYc7nw7h2pX/xF35",
+	"scmN8qLiIqO6PVTQgDBeRG0XpEDqAqXIP8SJt0Jug3d0fCq42b8jEc7t8FOE4J5DCTGgmmudmPDar/7U",
+	"/er6QH8HnnUsHvxnP2XfxJ3+zRSxtVq0VovWatFaLfoR1CK3fLR25T0NmQhlj28nEJVrKVF2tS3rW9kV",
+	"T7YNxKZCBhrLSZspFbHag9N+mPWSZPJdC1qPXaKktD6CRR3uXSfBlKgeLNyVXHQ5gz2rIoj0ZDs2zoTp",
+	"FQ00jFyBb02OhgfCD6+ZFUmEy4DjUPQ8lVykUqEnwwMRjmcav9EDs4Xfu8QH8XlzkVaShPpi8L4GXH2q",
+	"ewsOJ/4VtMuWLNcoWcSOiMRhAgzUA05zDucaXUyiJzVPVxXUYuVT8VkFQVI1LPAo9aYmgL1zcV8JM275",
+	"alBEqzwcCq8K/TnW2wXiqpJmfYlBfYVZzTVrfHJRLkIW5GKLiDSaNJxjvVkPWItdBkJSRbnv92OYckzT",
+	"glByk7IcSNwqSMUdS29RTouk5uvfp/wAPf3x7lW+SH34qwoRvQ+ZA+66mIq/i/T3ACmdQXirkNslhNMY",
+	"6s7Uc3YnqvlINy+7BSol7uQlkpmkxPk9+8r3J3M1Vk2yPUOSWF+o/FQvVD6goBu5p4jZ2VoL+JpawGNw",
+	"DdjcPnfkoqZcBFlJsVxPWzFmfd0MyfeBFKVrbjr/+KwACHBnMe2OTV6Ts5WM9TVxNVAyuOA9SRvyKgOW",
+	"1jNLXM9NdDHDJ1NRi93PvIsI+D7qLFznngRsrnPvTUPsjrIwjNC2kR/j/SmiR8f45vA1NpSZ1jOuTjcG",
+	"sbZeTYVYxiVLtRR72k+Ied5fe30c3dGh9CgGKw2CEkeLI7uWZ4X8eJ+rtY2jvrr/O8Ts5k7c4NTN19f1",
+	"+p6gekaImhlWm+f5DDgz/W3Ts2v3iUqtdVTeMmG0x7Bsjn28TKYhRwIXRnH28seof49tL91GGrD9hUlC",
+	"hViQtQn1qwtP7rFm2ix1n0GU/atSjEvfMnUwRqwp4lMoUK0vxf5uzFXLriJdk5MfgZw8sC72w3wXd9cK",
+	"avfU5x7XncMWJHe4x4CUwhu8+Ewd49xdf3TbOEOnr5Pyg4Av2kuJWQd175AuJH9Gi/qMFMNMFHqIYTnn",
+	"vKh0e1e2GF0aq4rXVOnLzuXy1j3qydsRt8LxOCoBXolIZkhDo9mEv/qt4+4p1jxonvQbw3a3szqQwVih",
+	"WkWPUd4dc3t3bre27D21W7O0YK64HN0Wx/v5BzO8cgFdKAzL72vB55ozSVfhd5Q/tzLhQX0qidA66+Ep",
+	"Bknr+5M0YrFiTGFrrkvt9dbf7zDBQFVqkD2x43FffynXLT2MPodBh20Z7SBbHYhrN8ccfu7oGC9HDav3",
+	"VGifT+gf8P3SrTjoJVE8X9Wfbj6j5niD7mj68E6S8RhtNg2+Ao7uf1U3AVa+s84IyVh2R4gtnixdDefs",
+	"qvgtDfoJCO1vYYTy2jj541RIIeFb7/GICYiLsuKduDoCWfhYZAUCQgvdIxcfEGdBhmKLrmqmqVKaRUdY",
+	"dqJm1dDi3kK7gAKaT48OjuVt8vLO8K9E8pfaCvRYP1xxluAx6HzwKDR+mgQqbgIDJFeFTcrCgQbZMnqQ",
+	"qJgYKZOW7FAtkJLJZb6O3nF3v33UOuu0hu+7/V/ODrrN1gC+Pmz/q9XMPXVV1lM4lu8XFFbVGaut6s+T",
+	"XrMxbC3pNBUZkw/dTe7n4Rua41nGQuYXwLH1H+98Wba8r1jtN6AAUJ3rKLvkwoB4LG6qVkZfi05r0Wkt",
+	"Ov3pRKdGr825ZoJ55PLtZSEnW1qqIXVh2518IwQEs6rNnLOuHLrt1xJqyu1BaeLDibmAmaY+9AWLgRi/",
+	"/YX/xRbz5FMYb3/Bt0P8WZ7dgsAgBBg+1KTvv2t/ipzivQdryg5KfSlyze7vR4EegI39zhySuqpoAmPg",
+	"POzAow+9K19cGfIUK7LdhUo8NBdNrBMfbl8iNZw5uqnCE0cGqUPKWWoiSsM4vUf73T43Mw6FiGOqbNbl",
+	"DrYQghWn+HfJMUvC8pFHShsz6zGlQfl0jT22p9jlrxSH/3iR9PcLCefvYEVgMWSCthI2VlWG9FlUmYQY",
+	"+6kyaso9OhlQ+tFkkUVXmP9reQdMrSSRWY6VSzYvdCQxTWJVxKACHKRCC+5VMw5CjgkNOu0DcVPvKudm",
+	"eIHF4/dBQ3sfBfNLR2kD/7MXoMMuTRYXlzMyIfhzdimS+o9lCkk4rHttKpYty2RHsXd8PssqBnlmixnm",
+	"bDd7zV+coa/qnl9PtMw8bOtxdQVdwtx5C7D4ZJBGyVW13r1Bv909lf1SEf44mTs6d9YRXeVqvFOmQ+4I",
+	"3DmfgZUt5Rr2DQnelePg4FF4LNmIFFsdjz7FyfUEsWAtKT+d3AbczcHiHDf4PHz61rFV/KTP9RXocxEz",
+	"3MR6ndWLtH0lUHggDYvjzBlhpmu/6JNSS3NqKJvOrXrCxEYj4pjX0WRCcToJWR4ylmhVsocw2KMV1fie",
+	"TfwyC0OEMlMjabbXoQ5Chskc9X/zBh3kMYObeAT8Phb6zF2EYex/K4Pvq0jCKuh4WZgDAnUSZ98Zwfpj",
+	"Qv8+VubOAOOaVjxVE1YGZ3gOeirWVCHVtZB0UJ2cIC3BIL1JEl/gFVhl9OQPowHlsRlkEUb2tz7Sjy1j",
+	"IBlYKlmgzZvKlq/Jw1MODmC2L9QxJ+snumDQiUs/y9s2hOr7x0kFt7f/HwcemBJ+tgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -141,6 +141,8 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) CreateFederation(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"fed-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateFederation(ctx)
 	return err
@@ -157,6 +159,8 @@ func (w *ServerInterfaceWrapper) AppInstCallbackLink(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-lcm"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AppInstCallbackLink(ctx, federationCallbackId)
 	return err
@@ -173,6 +177,8 @@ func (w *ServerInterfaceWrapper) AppStatusCallbackLink(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AppStatusCallbackLink(ctx, federationCallbackId)
 	return err
@@ -189,6 +195,8 @@ func (w *ServerInterfaceWrapper) ArtefactStatusCallbackLink(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ArtefactStatusCallbackLink(ctx, federationCallbackId)
 	return err
@@ -205,6 +213,8 @@ func (w *ServerInterfaceWrapper) AvailZoneNotifLink(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"zone-sync"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AvailZoneNotifLink(ctx, federationCallbackId)
 	return err
@@ -221,6 +231,8 @@ func (w *ServerInterfaceWrapper) FileStatusCallbackLink(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.FileStatusCallbackLink(ctx, federationCallbackId)
 	return err
@@ -237,6 +249,8 @@ func (w *ServerInterfaceWrapper) PartnerStatusLink(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"fed-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PartnerStatusLink(ctx, federationCallbackId)
 	return err
@@ -253,6 +267,8 @@ func (w *ServerInterfaceWrapper) ResourceReservationCallbackLink(ctx echo.Contex
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationCallbackId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"resource-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ResourceReservationCallbackLink(ctx, federationCallbackId)
 	return err
@@ -269,6 +285,8 @@ func (w *ServerInterfaceWrapper) InstallApp(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-lcm"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.InstallApp(ctx, federationContextId)
 	return err
@@ -301,6 +319,8 @@ func (w *ServerInterfaceWrapper) GetAllAppInstances(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appProviderId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-lcm"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetAllAppInstances(ctx, federationContextId, appId, appProviderId)
 	return err
@@ -341,6 +361,8 @@ func (w *ServerInterfaceWrapper) RemoveApp(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-lcm"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.RemoveApp(ctx, federationContextId, appId, appInstanceId, zoneId)
 	return err
@@ -381,6 +403,8 @@ func (w *ServerInterfaceWrapper) GetAppInstanceDetails(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-lcm"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetAppInstanceDetails(ctx, federationContextId, appId, appInstanceId, zoneId)
 	return err
@@ -397,6 +421,8 @@ func (w *ServerInterfaceWrapper) OnboardApplication(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.OnboardApplication(ctx, federationContextId)
 	return err
@@ -421,6 +447,8 @@ func (w *ServerInterfaceWrapper) DeleteApp(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteApp(ctx, federationContextId, appId)
 	return err
@@ -445,6 +473,8 @@ func (w *ServerInterfaceWrapper) ViewApplication(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ViewApplication(ctx, federationContextId, appId)
 	return err
@@ -469,6 +499,8 @@ func (w *ServerInterfaceWrapper) UpdateApplication(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateApplication(ctx, federationContextId, appId)
 	return err
@@ -493,6 +525,8 @@ func (w *ServerInterfaceWrapper) OnboardExistingAppNewZones(ctx echo.Context) er
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.OnboardExistingAppNewZones(ctx, federationContextId, appId)
 	return err
@@ -525,6 +559,8 @@ func (w *ServerInterfaceWrapper) DeboardApplication(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeboardApplication(ctx, federationContextId, appId, zoneId)
 	return err
@@ -549,6 +585,8 @@ func (w *ServerInterfaceWrapper) LockUnlockApplicationZone(ctx echo.Context) err
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"app-onboarding"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.LockUnlockApplicationZone(ctx, federationContextId, appId)
 	return err
@@ -565,6 +603,8 @@ func (w *ServerInterfaceWrapper) UploadArtefact(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UploadArtefact(ctx, federationContextId)
 	return err
@@ -589,6 +629,8 @@ func (w *ServerInterfaceWrapper) RemoveArtefact(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter artefactId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.RemoveArtefact(ctx, federationContextId, artefactId)
 	return err
@@ -613,6 +655,8 @@ func (w *ServerInterfaceWrapper) GetArtefact(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter artefactId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetArtefact(ctx, federationContextId, artefactId)
 	return err
@@ -629,6 +673,8 @@ func (w *ServerInterfaceWrapper) GetCandidateZones(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"edge-discovery"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetCandidateZones(ctx, federationContextId)
 	return err
@@ -645,6 +691,8 @@ func (w *ServerInterfaceWrapper) UploadFile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UploadFile(ctx, federationContextId)
 	return err
@@ -669,6 +717,8 @@ func (w *ServerInterfaceWrapper) RemoveFile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.RemoveFile(ctx, federationContextId, fileId)
 	return err
@@ -693,6 +743,8 @@ func (w *ServerInterfaceWrapper) ViewFile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter fileId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"artefact-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ViewFile(ctx, federationContextId, fileId)
 	return err
@@ -725,6 +777,8 @@ func (w *ServerInterfaceWrapper) ViewISVResPool(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appProviderId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"resource-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ViewISVResPool(ctx, federationContextId, zoneId, appProviderId)
 	return err
@@ -757,6 +811,8 @@ func (w *ServerInterfaceWrapper) CreateResourcePools(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter appProviderId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"resource-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.CreateResourcePools(ctx, federationContextId, zoneId, appProviderId)
 	return err
@@ -797,6 +853,8 @@ func (w *ServerInterfaceWrapper) RemoveISVResPool(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter poolId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"resource-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.RemoveISVResPool(ctx, federationContextId, zoneId, appProviderId, poolId)
 	return err
@@ -837,6 +895,8 @@ func (w *ServerInterfaceWrapper) UpdateISVResPool(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter poolId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"resource-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateISVResPool(ctx, federationContextId, zoneId, appProviderId, poolId)
 	return err
@@ -853,6 +913,8 @@ func (w *ServerInterfaceWrapper) DeleteFederationDetails(ctx echo.Context) error
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"fed-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteFederationDetails(ctx, federationContextId)
 	return err
@@ -869,6 +931,8 @@ func (w *ServerInterfaceWrapper) GetFederationDetails(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"fed-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetFederationDetails(ctx, federationContextId)
 	return err
@@ -885,6 +949,8 @@ func (w *ServerInterfaceWrapper) UpdateFederation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"fed-mgmt"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.UpdateFederation(ctx, federationContextId)
 	return err
@@ -917,6 +983,8 @@ func (w *ServerInterfaceWrapper) AuthenticateDevice(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter authToken: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"roaming-auth"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.AuthenticateDevice(ctx, federationContextId, deviceId, authToken)
 	return err
@@ -933,6 +1001,8 @@ func (w *ServerInterfaceWrapper) ZoneSubscribe(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter federationContextId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"zone-sync"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ZoneSubscribe(ctx, federationContextId)
 	return err
@@ -957,6 +1027,8 @@ func (w *ServerInterfaceWrapper) ZoneUnsubscribe(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"zone-sync"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ZoneUnsubscribe(ctx, federationContextId, zoneId)
 	return err
@@ -981,6 +1053,8 @@ func (w *ServerInterfaceWrapper) GetZoneData(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter zoneId: %s", err))
 	}
 
+	ctx.Set(OAuth2ClientCredentialsScopes, []string{"zone-sync"})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetZoneData(ctx, federationContextId, zoneId)
 	return err
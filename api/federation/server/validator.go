@@ -4,14 +4,15 @@ import (
 	"os"
 
 	"github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
 )
 
-func Validator() echo.MiddlewareFunc {
-	// Use validation middleware to check all requests against the OpenAPI schema.
+// Swagger returns the embedded OpenAPI spec the server is generated from.
+func Swagger() *openapi3.T {
 	swagger, err := models.GetSwagger()
 	if err != nil {
 		log.WithError(err).
@@ -21,6 +22,10 @@ func Validator() echo.MiddlewareFunc {
 	// Clear out the servers array in the swagger spec, that skips validating
 	// that server names match. We don't know how this thing will be run.
 	swagger.Servers = nil
+	return swagger
+}
 
+func Validator(swagger *openapi3.T) echo.MiddlewareFunc {
+	// Use validation middleware to check all requests against the OpenAPI schema.
 	return middleware.OapiRequestValidatorWithOptions(swagger, models.ValidatorOption(swagger))
 }
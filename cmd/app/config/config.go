@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	log "github.com/sirupsen/logrus"
 
 	"github.com/kelseyhightower/envconfig"
@@ -10,15 +12,114 @@ type Camara struct {
 	HostAgentAddr string `split_words:"true" default:"0.0.0.0:8080"`
 	LogLevel      string `split_words:"true" default:"info"`
 	ApiRoot       string `split_words:"true" default:"nearbyone.operator-name.nearbycomputing.com"`
+	// LatencyServiceAddr is the "host:port" of the service measuring the latency towards the edge zones
+	LatencyServiceAddr string `split_words:"true"`
+	// ServiceAddr is the "host:port" of this API, returned to the partner OPs as the edge discovery
+	// and LCM service endpoints. It defaults to the host of ApiRoot and the port of HostAgentAddr.
+	ServiceAddr string `split_words:"true"`
 }
 
 type Controller struct {
 	Namespace string `split_words:"true" required:"true"`
 }
 
+// DeviceAuth configures how the tokens of roaming devices are verified.
+// Verifier is either "jwt", using the home OP keys, or "http", posting the
+// token to HttpUrl with the callback credentials of the partner OP.
+type DeviceAuth struct {
+	Verifier    string `split_words:"true"`
+	JwtKeysFile string `split_words:"true"`
+	JwtIssuer   string `split_words:"true"`
+	HttpUrl     string `split_words:"true"`
+}
+
+// Auth configures the validation of the OAuth2 access tokens presented by partner OPs.
+// Tokens are verified with the keys of JwksFile or, if not set, JwksUrl. The
+// tokens of the embedded token server, if enabled, are accepted as well.
+// The server does not start when requests can be authenticated neither with
+// tokens nor with client certificates, unless Insecure is set: the partner OPs
+// are then identified by the unverified X-Client-ID header, which any caller
+// can set, and it must only be used for development.
+type Auth struct {
+	JwksFile            string        `split_words:"true"`
+	JwksUrl             string        `split_words:"true"`
+	JwksRefreshInterval time.Duration `split_words:"true" default:"1h"`
+	JwtIssuer           string        `split_words:"true"`
+	JwtAudience         string        `split_words:"true"`
+	ClientIdClaim       string        `split_words:"true" default:"client_id"`
+	Insecure            bool
+}
+
+// TokenServer configures the embedded OAuth2 token endpoint, issuing access tokens
+// to the partner OPs registered in ClientsFile. Issuer defaults to the api root and,
+// without SigningKeyFile, tokens are signed with a key generated on startup.
+type TokenServer struct {
+	Enabled        bool          `split_words:"true"`
+	ClientsFile    string        `split_words:"true"`
+	SigningKeyFile string        `split_words:"true"`
+	Issuer         string        `split_words:"true"`
+	Audience       string        `split_words:"true"`
+	TokenTtl       time.Duration `split_words:"true" default:"5m"`
+}
+
+// TLS configures the server certificate of the api, served over plain HTTP when
+// not set. With ClientCaFile partner OPs must present a client certificate signed
+// by one of its CAs (mTLS), and with ClientSubjectsFile the client id of the
+// requests is taken from the certificate subject. The certificate files are
+// reloaded when they change.
+type TLS struct {
+	CertFile           string `split_words:"true"`
+	KeyFile            string `split_words:"true"`
+	ClientCaFile       string `split_words:"true"`
+	ClientSubjectsFile string `split_words:"true"`
+}
+
+// OutboundTLS configures the client certificate presented in the callbacks and the
+// calls to partner OPs, and the CAs verifying them, the system ones when CaFile is
+// not set. The certificate files are reloaded when they change.
+type OutboundTLS struct {
+	CertFile string `split_words:"true"`
+	KeyFile  string `split_words:"true"`
+	CaFile   string `split_words:"true"`
+}
+
+// DebugLog configures the request and response payloads logged at debug level.
+// The values of the RedactPaths JSON paths and of the RedactHeaders are hidden,
+// see bodydump.Redactor for the path syntax.
+type DebugLog struct {
+	RedactPaths   []string `split_words:"true" default:"**.password,**.token,**.accessToken,**.clientSecret,**.access_token,**.client_secret"`
+	RedactHeaders []string `split_words:"true" default:"Authorization,Proxy-Authorization,Cookie,Set-Cookie"`
+}
+
+// RateLimit configures the limits of the partner OP requests, read from ConfigFile,
+// see ratelimit.Config for its format. Requests are not limited when not set.
+type RateLimit struct {
+	ConfigFile string `split_words:"true"`
+}
+
+// Audit configures where the audit records of the partner OP operations are
+// written: File, an append-only file, and HttpUrl, a collector the records are
+// posted to. Nothing is recorded when neither is set. The records are hash
+// chained, with an HMAC of the key of KeyFile if set, which auditverify needs
+// to check the file. The removal of the last records of the file can only be
+// detected by comparing it with the collector.
+type Audit struct {
+	File    string
+	HttpUrl string `split_words:"true"`
+	KeyFile string `split_words:"true"`
+}
+
 type Config struct {
 	Camara
 	Controller
+	DeviceAuth
+	Auth
+	TokenServer
+	TLS
+	OutboundTLS
+	DebugLog
+	RateLimit
+	Audit
 }
 
 func process(prefix string, spec interface{}) {
@@ -34,5 +135,29 @@ func GetConf() Config {
 	var controller Controller
 	process("controller", &controller)
 
-	return Config{camara, controller}
+	var deviceAuth DeviceAuth
+	process("deviceauth", &deviceAuth)
+
+	var auth Auth
+	process("auth", &auth)
+
+	var tokenServer TokenServer
+	process("tokenserver", &tokenServer)
+
+	var tls TLS
+	process("tls", &tls)
+
+	var outboundTLS OutboundTLS
+	process("outboundtls", &outboundTLS)
+
+	var debugLog DebugLog
+	process("debuglog", &debugLog)
+
+	var rateLimit RateLimit
+	process("ratelimit", &rateLimit)
+
+	var audit Audit
+	process("audit", &audit)
+
+	return Config{camara, controller, deviceAuth, auth, tokenServer, tls, outboundTLS, debugLog, rateLimit, audit}
 }
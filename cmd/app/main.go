@@ -1,20 +1,38 @@
 package main
 
 import (
-	"encoding/json"
+	"crypto"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"strings"
+	"time"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/server"
 	"github.com/neonephos-katalis/opg-ewbi-api/cmd/app/config"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/audit"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/bodydump"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/callback"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/deviceauth"
 	"github.com/neonephos-katalis/opg-ewbi-api/pkg/handler"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/ratelimit"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/routes"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/tlsconfig"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/tokenserver"
 	opgv1beta1 "github.com/neonephos-katalis/opg-ewbi-operator/api/v1beta1"
 )
 
@@ -35,18 +53,9 @@ func main() {
 	}
 
 	e := echo.New()
-	// Captures request and response payloads and log them
+	// Captures request and response payloads and log them, without their secrets
 	if conf.Camara.LogLevel == "debug" {
-		e.Use(middleware.BodyDump(func(c echo.Context, reqBody, resBody []byte) {
-			var reqBodyMap, resBodyMap map[string]any
-			json.Unmarshal([]byte(reqBody), &reqBodyMap)
-			json.Unmarshal([]byte(resBody), &resBodyMap)
-			log.WithContext(c.Request().Context()).WithFields(
-				logrus.Fields{
-					"reqBody:": reqBodyMap,
-					"resBody:": resBodyMap,
-				}).Debug("request")
-		}))
+		e.Use(bodydump.Middleware(bodydump.NewRedactor(conf.DebugLog.RedactPaths, conf.DebugLog.RedactHeaders)))
 	}
 	// Log all requests
 	e.Use(middleware.RequestLoggerWithConfig(middleware.RequestLoggerConfig{
@@ -68,10 +77,8 @@ func main() {
 			return nil
 		},
 	}))
-	// Validate request and return errors using the expected models.ProblemDetails format
-	e.Use(server.Validator())
-
 	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(opgv1beta1.AddToScheme(scheme))
 
 	config := ctrl.GetConfigOrDie()
@@ -83,12 +90,252 @@ func main() {
 			Fatal("failed to create k8sclient")
 	}
 
-	h := handler.NewServer(conf.Camara.ApiRoot, k8sClient, conf.Controller.Namespace)
+	var opts []handler.Option
+	if conf.Camara.LatencyServiceAddr != "" {
+		endpoint, err := handler.NewServiceEndpoint(conf.Camara.LatencyServiceAddr)
+		if err != nil {
+			log.WithError(err).
+				Fatal("invalid latency service address")
+		}
+		opts = append(opts, handler.WithLatencyServiceEndpoint(endpoint))
+	}
+	serviceEndpoint, err := handler.NewServiceEndpoint(serviceAddr(conf))
+	if err != nil {
+		log.WithError(err).
+			Fatal("invalid service address")
+	}
+	opts = append(opts, handler.WithServiceEndpoint(serviceEndpoint))
+
+	outbound := newOutboundTransport(conf)
+	if outbound != nil {
+		opts = append(opts, handler.WithCallbackClient(callback.NewClient(&http.Client{Transport: outbound, Timeout: 30 * time.Second})))
+	}
+
+	switch conf.DeviceAuth.Verifier {
+	case "":
+	case "jwt":
+		keys, err := jwks.LoadFile(conf.DeviceAuth.JwtKeysFile)
+		if err != nil {
+			log.WithError(err).
+				Fatal("failed to load device auth keys")
+		}
+		opts = append(opts, handler.WithDeviceTokenVerifier(deviceauth.NewJWTVerifier(keys, conf.DeviceAuth.JwtIssuer)))
+	case "http":
+		var httpClient *http.Client
+		if outbound != nil {
+			httpClient = &http.Client{Transport: outbound, Timeout: 10 * time.Second}
+		}
+		// the home OP is the partner OP of the federation, called with its callback credentials
+		store := metastore.NewK8sClient(k8sClient, conf.Controller.Namespace)
+		opts = append(opts, handler.WithDeviceTokenVerifier(deviceauth.NewHTTPVerifier(conf.DeviceAuth.HttpUrl, store.GetPartnerCallbackCredentials, httpClient)))
+	default:
+		log.Fatalf("unknown device auth verifier '%s'", conf.DeviceAuth.Verifier)
+	}
+
+	var tokenServer *tokenserver.Server
+	if conf.TokenServer.Enabled {
+		tokenServer = newTokenServer(conf)
+		e.Pre(tokenServer.Middleware())
+	}
+
+	// tokens of the embedded token server and of the external identity provider are both accepted
+	var validators clientauth.Validators
+	if tokenServer != nil {
+		validators = append(validators, clientauth.NewJWTValidator(
+			tokenServer.KeySet().Keyfunc, tokenServerIssuer(conf), conf.TokenServer.Audience, "client_id"))
+	}
+	var keyfunc jwt.Keyfunc
+	switch {
+	case conf.Auth.JwksFile != "":
+		keys, err := jwks.LoadFile(conf.Auth.JwksFile)
+		if err != nil {
+			log.WithError(err).
+				Fatal("failed to load auth keys")
+		}
+		keyfunc = keys.Keyfunc
+	case conf.Auth.JwksUrl != "":
+		var httpClient *http.Client
+		if outbound != nil {
+			httpClient = &http.Client{Transport: outbound, Timeout: 10 * time.Second}
+		}
+		keyfunc = jwks.NewRemote(conf.Auth.JwksUrl, httpClient, conf.Auth.JwksRefreshInterval).Keyfunc
+	}
+	if keyfunc != nil {
+		validators = append(validators, clientauth.NewJWTValidator(
+			keyfunc, conf.Auth.JwtIssuer, conf.Auth.JwtAudience, conf.Auth.ClientIdClaim))
+	}
+	if len(validators) > 0 {
+		opts = append(opts, handler.WithTokenValidator(validators))
+	}
+
+	if conf.TLS.ClientSubjectsFile != "" {
+		if conf.TLS.CertFile == "" || conf.TLS.ClientCaFile == "" {
+			log.Fatal("client certificate subjects require a server certificate and a client CA")
+		}
+		subjects, err := clientauth.LoadCertificateSubjects(conf.TLS.ClientSubjectsFile)
+		if err != nil {
+			log.WithError(err).
+				Fatal("failed to load client certificate subjects")
+		}
+		opts = append(opts, handler.WithCertificateSubjects(subjects))
+	}
+	if len(validators) == 0 && conf.TLS.ClientSubjectsFile == "" {
+		if !conf.Auth.Insecure {
+			log.Fatal("no auth keys nor client certificate subjects configured, set AUTH_INSECURE to accept unauthenticated requests")
+		}
+		log.Warn("requests are not authenticated, partner OPs are identified by the X-Client-ID header")
+	}
+
+	h := handler.NewServer(conf.Camara.ApiRoot, k8sClient, conf.Controller.Namespace, opts...)
 	server.RegisterHandlers(e, h)
+	swagger := server.Swagger()
+	operations := routes.New(swagger)
+	if auditLogger := newAuditLogger(conf, outbound); auditLogger != nil {
+		e.Use(handler.AuditMiddleware(h, auditLogger, operations))
+	}
+	// Validate request and return errors using the expected models.ProblemDetails format
+	e.Use(server.Validator(swagger))
 	e.Use(handler.AuthMiddleware(h))
+	e.Use(handler.ScopeMiddleware(operations))
+	if conf.RateLimit.ConfigFile != "" {
+		limits, err := ratelimit.LoadConfig(conf.RateLimit.ConfigFile)
+		if err != nil {
+			log.WithError(err).
+				Fatal("failed to load rate limits")
+		}
+		e.Use(handler.RateLimitMiddleware(h, ratelimit.New(limits), operations))
+	}
+	e.Use(handler.AuthorizationMiddleware(h))
 
-	if err := e.Start(conf.Camara.HostAgentAddr); err != nil {
+	if conf.TLS.CertFile != "" {
+		err = e.StartServer(&http.Server{Addr: conf.Camara.HostAgentAddr, TLSConfig: newServerTLSConfig(conf)})
+	} else {
+		err = e.Start(conf.Camara.HostAgentAddr)
+	}
+	if err != nil {
 		log.WithError(err).
 			Fatal("failed to run server")
 	}
 }
+
+func newTokenServer(conf config.Config) *tokenserver.Server {
+	clients, err := tokenserver.LoadClients(conf.TokenServer.ClientsFile)
+	if err != nil {
+		log.WithError(err).
+			Fatal("failed to load token server clients")
+	}
+	var key crypto.Signer
+	if conf.TokenServer.SigningKeyFile != "" {
+		key, err = tokenserver.LoadSigningKey(conf.TokenServer.SigningKeyFile)
+	} else {
+		log.Warn("no token server signing key configured, issued tokens will not survive a restart")
+		key, err = tokenserver.GenerateSigningKey()
+	}
+	if err != nil {
+		log.WithError(err).
+			Fatal("failed to load token server signing key")
+	}
+	s, err := tokenserver.New(clients, key, tokenServerIssuer(conf), conf.TokenServer.Audience, conf.TokenServer.TokenTtl)
+	if err != nil {
+		log.WithError(err).
+			Fatal("failed to create token server")
+	}
+	return s
+}
+
+// serviceAddr returns the address of this API advertised to the partner OPs.
+func serviceAddr(conf config.Config) string {
+	if conf.Camara.ServiceAddr != "" {
+		return conf.Camara.ServiceAddr
+	}
+	host := conf.Camara.ApiRoot
+	if _, rest, ok := strings.Cut(host, "://"); ok {
+		host = rest
+	}
+	host, _, _ = strings.Cut(host, "/")
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	_, port, _ := net.SplitHostPort(conf.Camara.HostAgentAddr)
+	return net.JoinHostPort(host, port)
+}
+
+func tokenServerIssuer(conf config.Config) string {
+	if conf.TokenServer.Issuer != "" {
+		return conf.TokenServer.Issuer
+	}
+	return conf.Camara.ApiRoot
+}
+
+func newServerTLSConfig(conf config.Config) *tls.Config {
+	cert, err := tlsconfig.LoadCertificate(conf.TLS.CertFile, conf.TLS.KeyFile)
+	if err != nil {
+		log.WithError(err).
+			Fatal("failed to load server certificate")
+	}
+	var clientCAs *tlsconfig.CertPool
+	if conf.TLS.ClientCaFile != "" {
+		if clientCAs, err = tlsconfig.LoadCertPool(conf.TLS.ClientCaFile); err != nil {
+			log.WithError(err).
+				Fatal("failed to load client CA")
+		}
+	}
+	return tlsconfig.Server(cert, clientCAs)
+}
+
+// newAuditLogger returns the logger of the configured audit sinks, or nil when
+// auditing is disabled.
+func newAuditLogger(conf config.Config, outbound http.RoundTripper) *audit.Logger {
+	var sinks []audit.Sink
+	if conf.Audit.File != "" {
+		sink, err := audit.OpenFileSink(conf.Audit.File)
+		if err != nil {
+			log.WithError(err).
+				Fatal("failed to open audit file")
+		}
+		sinks = append(sinks, sink)
+	}
+	if conf.Audit.HttpUrl != "" {
+		var httpClient *http.Client
+		if outbound != nil {
+			httpClient = &http.Client{Transport: outbound, Timeout: 10 * time.Second}
+		}
+		sinks = append(sinks, audit.NewHTTPSink(conf.Audit.HttpUrl, httpClient))
+	}
+	if len(sinks) == 0 {
+		return nil
+	}
+	var key []byte
+	if conf.Audit.KeyFile != "" {
+		var err error
+		if key, err = audit.LoadKey(conf.Audit.KeyFile); err != nil {
+			log.WithError(err).
+				Fatal("failed to load audit key")
+		}
+	}
+	return audit.NewLogger(key, sinks...)
+}
+
+// newOutboundTransport returns the transport of the calls to the partner OPs,
+// or nil when no outbound TLS setting is configured.
+func newOutboundTransport(conf config.Config) http.RoundTripper {
+	if conf.OutboundTLS.CertFile == "" && conf.OutboundTLS.CaFile == "" {
+		return nil
+	}
+	var cert *tlsconfig.Certificate
+	var rootCAs *tlsconfig.CertPool
+	var err error
+	if conf.OutboundTLS.CertFile != "" {
+		if cert, err = tlsconfig.LoadCertificate(conf.OutboundTLS.CertFile, conf.OutboundTLS.KeyFile); err != nil {
+			log.WithError(err).
+				Fatal("failed to load outbound client certificate")
+		}
+	}
+	if conf.OutboundTLS.CaFile != "" {
+		if rootCAs, err = tlsconfig.LoadCertPool(conf.OutboundTLS.CaFile); err != nil {
+			log.WithError(err).
+				Fatal("failed to load outbound CA")
+		}
+	}
+	return tlsconfig.Transport(cert, rootCAs)
+}
@@ -0,0 +1,49 @@
+// Command auditverify checks the hash chain of an audit file written by the
+// API server, exiting with a non-zero status when the file was tampered with.
+// The records removed from the end of the file keep the chain valid: compare
+// the number of records with the last sequence received by the collector.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/audit"
+)
+
+func main() {
+	keyFile := flag.String("key", "", "file holding the key of the audit chain (AUDIT_KEY_FILE)")
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "usage: %s [-key <key file>] <audit file>\n", os.Args[0])
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var key []byte
+	if *keyFile != "" {
+		var err error
+		if key, err = audit.LoadKey(*keyFile); err != nil {
+			log.WithError(err).
+				Fatal("failed to load audit key")
+		}
+	}
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.WithError(err).
+			Fatal("failed to open audit file")
+	}
+	defer f.Close()
+
+	n, err := audit.Verify(f, key)
+	if err != nil {
+		log.WithError(err).
+			Fatal("audit file verification failed")
+	}
+	fmt.Printf("%d records verified\n", n)
+}
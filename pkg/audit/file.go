@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// maxLineSize is the maximum size of a line of the audit file.
+const maxLineSize = 1 << 20
+
+// FileSink appends the entries to a file, one JSON entry per line.
+type FileSink struct {
+	mu       sync.Mutex
+	file     *os.File
+	sequence uint64
+	lastHash string
+}
+
+// OpenFileSink opens the audit file for appending, remembering the last entry
+// of the chain if it is not empty.
+func OpenFileSink(path string) (*FileSink, error) {
+	s := &FileSink{}
+	if f, err := os.Open(path); err == nil {
+		err = scanEntries(f, func(_ int, entry Entry, record Record) error {
+			s.sequence, s.lastHash = record.Sequence, entry.Hash
+			return nil
+		})
+		f.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid audit file '%s'", path)
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "unable to read audit file '%s'", path)
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open audit file '%s'", path)
+	}
+	s.file = f
+	return s, nil
+}
+
+// Last returns the sequence and the hash of the last entry of the file.
+func (s *FileSink) Last() (uint64, string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sequence, s.lastHash
+}
+
+func (s *FileSink) Write(entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit record")
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return errors.Wrap(err, "failed to write audit record")
+	}
+	if err := s.file.Sync(); err != nil {
+		return errors.Wrap(err, "failed to write audit record")
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// Verify checks the hash chain of an audit file, written with the given key,
+// and returns the number of records. Removing the last records of the file
+// keeps the chain valid: the count must be checked against the sequence of
+// the last record received by the collector to detect it.
+func Verify(r io.Reader, key []byte) (int, error) {
+	var count int
+	var prevHash string
+	err := scanEntries(r, func(line int, entry Entry, record Record) error {
+		if h := hash(key, entry.Record); h != entry.Hash {
+			return errors.Errorf("line %d: record hash is %s, expected %s", line, h, entry.Hash)
+		}
+		if record.PrevHash != prevHash {
+			return errors.Errorf("line %d: record follows %s, expected %s", line, record.PrevHash, prevHash)
+		}
+		if record.Sequence != uint64(count+1) {
+			return errors.Errorf("line %d: record sequence is %d, expected %d", line, record.Sequence, count+1)
+		}
+		count, prevHash = count+1, entry.Hash
+		return nil
+	})
+	return count, err
+}
+
+func scanEntries(r io.Reader, fn func(line int, entry Entry, record Record) error) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return errors.Errorf("line %d: invalid entry: %s", line, err.Error())
+		}
+		var record Record
+		if err := json.Unmarshal(entry.Record, &record); err != nil {
+			return errors.Errorf("line %d: invalid record: %s", line, err.Error())
+		}
+		if err := fn(line, entry, record); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	key := []byte("secret")
+	record := Record{
+		Time:                time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClientID:            "partner",
+		FederationContextID: "fed",
+		Operation:           "InstallApp",
+		Method:              "POST",
+		Path:                "/fed/application/lcm",
+		Targets:             map[string]string{"appId": "app"},
+		Status:              202,
+		Outcome:             OutcomeSuccess,
+	}
+
+	s, err := OpenFileSink(path)
+	require.NoError(t, err)
+	l := NewLogger(key, s)
+	l.Log(record)
+	l.Log(record)
+	require.NoError(t, s.Close())
+
+	// the chain continues after reopening the file
+	s, err = OpenFileSink(path)
+	require.NoError(t, err)
+	NewLogger(key, s).Log(record)
+	require.NoError(t, s.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	n, err := Verify(bytes.NewReader(data), key)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	t.Run("Modified record", func(t *testing.T) {
+		tampered := strings.Replace(string(data), `"status":202`, `"status":403`, 1)
+		_, err := Verify(strings.NewReader(tampered), key)
+		require.ErrorContains(t, err, "line 1: record hash")
+	})
+
+	t.Run("Removed record", func(t *testing.T) {
+		lines := strings.SplitAfter(string(data), "\n")
+		_, err := Verify(strings.NewReader(lines[0]+lines[2]), key)
+		require.ErrorContains(t, err, "line 2: record follows")
+	})
+
+	t.Run("Other key", func(t *testing.T) {
+		_, err := Verify(bytes.NewReader(data), []byte("other"))
+		require.ErrorContains(t, err, "line 1: record hash")
+
+		_, err = Verify(bytes.NewReader(data), nil)
+		require.ErrorContains(t, err, "line 1: record hash")
+	})
+}
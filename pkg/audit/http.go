@@ -0,0 +1,77 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultTimeout   = 10 * time.Second
+	defaultQueueSize = 1000
+)
+
+// HTTPSink posts each chained entry as JSON to an external collector, which
+// can verify the chain and, holding a copy out of reach of the server, detect
+// the records removed from the end of the audit file. Entries are sent in the
+// background, in order, so that the collector does not delay the requests;
+// they are dropped when the queue is full, which the collector sees as a gap in
+// the sequence.
+type HTTPSink struct {
+	url        string
+	httpClient *http.Client
+	queue      chan Entry
+}
+
+func NewHTTPSink(url string, httpClient *http.Client) *HTTPSink {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	s := &HTTPSink{url: url, httpClient: httpClient, queue: make(chan Entry, defaultQueueSize)}
+	go s.run()
+	return s
+}
+
+func (s *HTTPSink) Write(entry Entry) error {
+	select {
+	case s.queue <- entry:
+		return nil
+	default:
+		return errors.New("audit collector queue is full")
+	}
+}
+
+func (s *HTTPSink) run() {
+	for entry := range s.queue {
+		if err := s.send(entry); err != nil {
+			log.WithError(err).
+				Error("failed to send audit record")
+		}
+	}
+}
+
+func (s *HTTPSink) send(entry Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal audit record")
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Wrap(err, "invalid audit collector url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to reach audit collector")
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("audit collector returned unexpected status %d", res.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_HTTPSink(t *testing.T) {
+	received := make(chan Entry, 2)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var entry Entry
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&entry))
+		received <- entry
+	}))
+	defer srv.Close()
+
+	l := NewLogger(nil, NewHTTPSink(srv.URL, nil))
+	l.Log(Record{Operation: "InstallApp"})
+	l.Log(Record{Operation: "RemoveApp"})
+
+	// the collector receives the chained entries
+	var prevHash string
+	for i := 1; i <= 2; i++ {
+		select {
+		case entry := <-received:
+			var record Record
+			require.NoError(t, json.Unmarshal(entry.Record, &record))
+			require.Equal(t, uint64(i), record.Sequence)
+			require.Equal(t, prevHash, record.PrevHash)
+			require.Equal(t, hash(nil, entry.Record), entry.Hash)
+			prevHash = entry.Hash
+		case <-time.After(5 * time.Second):
+			t.Fatal("audit entry not received")
+		}
+	}
+}
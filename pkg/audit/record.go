@@ -0,0 +1,128 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// Record describes an operation a partner OP requested on one of the federations.
+// Sequence and PrevHash chain the records written by a logger.
+type Record struct {
+	Sequence             uint64            `json:"sequence,omitempty"`
+	PrevHash             string            `json:"prevHash,omitempty"`
+	Time                 time.Time         `json:"time"`
+	ClientID             string            `json:"clientId,omitempty"`
+	FederationContextID  string            `json:"federationContextId,omitempty"`
+	FederationCallbackID string            `json:"federationCallbackId,omitempty"`
+	Operation            string            `json:"operation"`
+	Method               string            `json:"method"`
+	Path                 string            `json:"path"`
+	Targets              map[string]string `json:"targets,omitempty"`
+	Status               int               `json:"status"`
+	Outcome              string            `json:"outcome"`
+}
+
+// Entry is a chained record as written to the sinks. Hash is computed over the
+// exact bytes of the record, which holds the hash of the previous entry, so
+// that any change to an entry breaks the chain. It is an HMAC-SHA256 when the
+// logger has a key, so that the chain cannot be recomputed without it, and a
+// plain SHA-256 otherwise.
+type Entry struct {
+	Hash   string          `json:"hash"`
+	Record json.RawMessage `json:"record"`
+}
+
+// Sink stores the audit entries.
+type Sink interface {
+	Write(entry Entry) error
+}
+
+// chainedSink is a sink that already holds entries, which the chain continues.
+type chainedSink interface {
+	Last() (sequence uint64, hash string)
+}
+
+// Logger chains the audit records and writes them to all its sinks.
+type Logger struct {
+	mu       sync.Mutex
+	key      []byte
+	sinks    []Sink
+	sequence uint64
+	lastHash string
+}
+
+// NewLogger returns a logger chaining the records with the given key, which may
+// be empty. The chain continues the one of the first sink holding entries.
+func NewLogger(key []byte, sinks ...Sink) *Logger {
+	l := &Logger{key: key, sinks: sinks}
+	for _, s := range sinks {
+		if c, ok := s.(chainedSink); ok {
+			l.sequence, l.lastHash = c.Last()
+			break
+		}
+	}
+	return l
+}
+
+// Log chains the record and writes it to every sink. Failures are logged, they
+// do not prevent the record from reaching the other sinks; the chain goes on,
+// so a sink that missed a record fails verification.
+func (l *Logger) Log(record Record) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record.Sequence, record.PrevHash = l.sequence+1, l.lastHash
+	raw, err := json.Marshal(record)
+	if err != nil {
+		log.WithError(err).
+			WithField("operation", record.Operation).
+			Error("failed to marshal audit record")
+		return
+	}
+	entry := Entry{Hash: hash(l.key, raw), Record: raw}
+	for _, s := range l.sinks {
+		if err := s.Write(entry); err != nil {
+			log.WithError(err).
+				WithField("operation", record.Operation).
+				Error("failed to write audit record")
+		}
+	}
+	l.sequence, l.lastHash = record.Sequence, entry.Hash
+}
+
+// LoadKey reads the key of the audit chain from a file.
+func LoadKey(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read audit key '%s'", path)
+	}
+	key := bytes.TrimSpace(data)
+	if len(key) == 0 {
+		return nil, errors.Errorf("audit key '%s' is empty", path)
+	}
+	return key, nil
+}
+
+func hash(key, data []byte) string {
+	if len(key) == 0 {
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:])
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,22 @@
+package bodydump
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	log "github.com/sirupsen/logrus"
+)
+
+// Middleware captures the request and response payloads and logs them at debug
+// level, with the secrets hidden by the redactor.
+func Middleware(redactor *Redactor) echo.MiddlewareFunc {
+	return middleware.BodyDump(func(c echo.Context, reqBody, resBody []byte) {
+		req, res := c.Request(), c.Response()
+		log.WithContext(req.Context()).WithFields(
+			log.Fields{
+				"reqHeaders:": redactor.Headers(req.Header),
+				"reqBody:":    redactor.Body(req.Header.Get(echo.HeaderContentType), reqBody),
+				"resHeaders:": redactor.Headers(res.Header()),
+				"resBody:":    redactor.Body(res.Header().Get(echo.HeaderContentType), resBody),
+			}).Debug("request")
+	})
+}
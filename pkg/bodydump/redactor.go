@@ -0,0 +1,153 @@
+package bodydump
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// Redactor hides the secrets of the logged requests and responses.
+//
+// JSON paths are dot separated object keys, where "*" matches any key and "**"
+// any number of nested objects, so "**.password" matches a password at any
+// depth. Arrays are traversed without consuming a path segment.
+// Header names are case insensitive.
+type Redactor struct {
+	paths   [][]string
+	headers map[string]bool
+}
+
+// NewRedactor returns a redactor hiding the values of the JSON paths and headers.
+func NewRedactor(paths, headers []string) *Redactor {
+	r := &Redactor{headers: map[string]bool{}}
+	for _, p := range paths {
+		if p = strings.TrimSpace(p); p != "" {
+			r.paths = append(r.paths, strings.Split(p, "."))
+		}
+	}
+	for _, h := range headers {
+		if h = strings.TrimSpace(h); h != "" {
+			r.headers[http.CanonicalHeaderKey(h)] = true
+		}
+	}
+	return r
+}
+
+// Headers returns the headers with the values of the redacted ones hidden.
+func (r *Redactor) Headers(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		if r.headers[http.CanonicalHeaderKey(name)] {
+			out[name] = redacted
+			continue
+		}
+		out[name] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+// Body returns the body with the values of the redacted paths hidden.
+// Multipart forms are summarised as an object of their fields, where files
+// are replaced by their name, content type and size. Bodies that are not
+// JSON are replaced by their size.
+func (r *Redactor) Body(contentType string, body []byte) any {
+	if len(body) == 0 {
+		return nil
+	}
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+	if mediaType == "multipart/form-data" {
+		return r.redact(multipartSummary(body, params["boundary"]))
+	}
+	var v any
+	if err := json.Unmarshal(body, &v); err != nil {
+		return fmt.Sprintf("<%d bytes of %s>", len(body), contentType)
+	}
+	return r.redact(v)
+}
+
+func (r *Redactor) redact(v any) any {
+	for _, path := range r.paths {
+		v = redactPath(v, path)
+	}
+	return v
+}
+
+func redactPath(v any, path []string) any {
+	if len(path) == 0 {
+		return redacted
+	}
+	switch t := v.(type) {
+	case []any:
+		for i, e := range t {
+			t[i] = redactPath(e, path)
+		}
+	case map[string]any:
+		segment, rest := path[0], path[1:]
+		if segment == "**" {
+			if len(rest) == 0 {
+				return redacted
+			}
+			redactPath(t, rest)
+			for k, e := range t {
+				t[k] = redactPath(e, path)
+			}
+			return t
+		}
+		for k, e := range t {
+			if segment == "*" || segment == k {
+				t[k] = redactPath(e, rest)
+			}
+		}
+	}
+	return v
+}
+
+// multipartSummary returns the fields of the form, decoded if they hold JSON,
+// and a summary of its files.
+func multipartSummary(body []byte, boundary string) any {
+	form := map[string]any{}
+	repeated := map[string]bool{}
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Sprintf("<%d bytes of invalid multipart form>", len(body))
+		}
+		var value any
+		if part.FileName() != "" {
+			size, _ := io.Copy(io.Discard, part)
+			value = map[string]any{
+				"fileName":    part.FileName(),
+				"contentType": part.Header.Get("Content-Type"),
+				"size":        size,
+			}
+		} else {
+			data, _ := io.ReadAll(part)
+			if err := json.Unmarshal(data, &value); err != nil {
+				value = string(data)
+			}
+		}
+		name := part.FormName()
+		existing, ok := form[name]
+		switch {
+		case !ok:
+			form[name] = value
+		case repeated[name]:
+			form[name] = append(existing.([]any), value)
+		default:
+			form[name] = []any{existing, value}
+			repeated[name] = true
+		}
+	}
+	return form
+}
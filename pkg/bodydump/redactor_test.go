@@ -0,0 +1,99 @@
+package bodydump
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Redactor_Body(t *testing.T) {
+	r := NewRedactor([]string{"**.password", "partnerCallbackCredentials.clientSecret", "appMetaData.*"}, nil)
+
+	tests := []struct {
+		name     string
+		body     string
+		expected any
+	}{
+		{
+			name: "Nested path",
+			body: `{"fileRepoLocation":{"userName":"user","password":"secret"}}`,
+			expected: map[string]any{"fileRepoLocation": map[string]any{
+				"userName": "user",
+				"password": redacted,
+			}},
+		},
+		{
+			name:     "Top level key matched at any depth",
+			body:     `{"password":"secret"}`,
+			expected: map[string]any{"password": redacted},
+		},
+		{
+			name: "Arrays traversed",
+			body: `[{"repo":{"password":"secret"}},{"password":"secret"}]`,
+			expected: []any{
+				map[string]any{"repo": map[string]any{"password": redacted}},
+				map[string]any{"password": redacted},
+			},
+		},
+		{
+			name: "Exact path",
+			body: `{"partnerCallbackCredentials":{"clientId":"id","clientSecret":"secret"},"clientSecret":"kept"}`,
+			expected: map[string]any{
+				"partnerCallbackCredentials": map[string]any{"clientId": "id", "clientSecret": redacted},
+				"clientSecret":               "kept",
+			},
+		},
+		{
+			name:     "Wildcard key",
+			body:     `{"appMetaData":{"accessToken":"secret","appName":"app"}}`,
+			expected: map[string]any{"appMetaData": map[string]any{"accessToken": redacted, "appName": redacted}},
+		},
+		{
+			name:     "Not JSON",
+			body:     `plain`,
+			expected: "<5 bytes of application/json>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, r.Body("application/json", []byte(tt.body)))
+		})
+	}
+
+	t.Run("Multipart form summarised", func(t *testing.T) {
+		var body bytes.Buffer
+		w := multipart.NewWriter(&body)
+		require.NoError(t, w.WriteField("fileId", "file"))
+		require.NoError(t, w.WriteField("fileRepoLocation", `{"repoURL":"https://repo.example","password":"secret"}`))
+		part, err := w.CreateFormFile("file", "image.qcow2")
+		require.NoError(t, err)
+		_, err = part.Write(make([]byte, 1024))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		require.Equal(t, map[string]any{
+			"fileId":           "file",
+			"fileRepoLocation": map[string]any{"repoURL": "https://repo.example", "password": redacted},
+			"file": map[string]any{
+				"fileName":    "image.qcow2",
+				"contentType": "application/octet-stream",
+				"size":        int64(1024),
+			},
+		}, r.Body(w.FormDataContentType(), body.Bytes()))
+	})
+}
+
+func Test_Redactor_Headers(t *testing.T) {
+	r := NewRedactor(nil, []string{"authorization"})
+	header := http.Header{}
+	header.Set("Authorization", "Bearer token")
+	header.Set("X-Client-ID", "partner")
+
+	require.Equal(t, map[string]string{
+		"Authorization": redacted,
+		"X-Client-Id":   "partner",
+	}, r.Headers(header))
+}
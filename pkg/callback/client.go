@@ -0,0 +1,74 @@
+package callback
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/partnerclient"
+)
+
+const (
+	headerKeyClientID = "X-Client-ID"
+	defaultTimeout    = 30 * time.Second
+)
+
+var _ Client = &client{}
+
+// Client notifies the originating OP through the callback links it provided in its requests.
+// The callbacks are authenticated with an access token obtained with the callback
+// credentials the originating OP provided, when it provided some.
+type Client interface {
+	NotifyResourceReservation(ctx context.Context, link string, credentials *models.CallbackCredentials, body *models.ResourceReservationCallbackLinkJSONRequestBody) error
+}
+
+func NewClient(httpClient *http.Client) *client {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &client{httpClients: partnerclient.NewHTTPClients(httpClient)}
+}
+
+type client struct {
+	httpClients *partnerclient.HTTPClients
+}
+
+func (c *client) NotifyResourceReservation(ctx context.Context, link string, credentials *models.CallbackCredentials, body *models.ResourceReservationCallbackLinkJSONRequestBody) error {
+	return c.post(ctx, link, credentials, body)
+}
+
+func (c *client) post(ctx context.Context, link string, credentials *models.CallbackCredentials, body any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal callback body")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, link, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrapf(err, "invalid callback link '%s'", link)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if credentials != nil {
+		req.Header.Set(headerKeyClientID, credentials.ClientId)
+	}
+
+	res, err := c.httpClients.Get(credentials).Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to send callback to '%s'", link)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		problem := models.ProblemDetails{}
+		if err := json.NewDecoder(res.Body).Decode(&problem); err == nil && problem.Detail != nil {
+			return fmt.Errorf("callback to '%s' returned status %d: %s", link, res.StatusCode, *problem.Detail)
+		}
+		return fmt.Errorf("callback to '%s' returned status %d", link, res.StatusCode)
+	}
+	return nil
+}
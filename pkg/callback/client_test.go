@@ -0,0 +1,52 @@
+package callback
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func Test_NotifyResourceReservation(t *testing.T) {
+	var tokens int
+	var authorization, clientID string
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		user, password, _ := r.BasicAuth()
+		require.Equal(t, "client", user+r.Form.Get("client_id"))
+		require.Equal(t, "secret", password+r.Form.Get("client_secret"))
+		tokens++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("POST /callback", func(w http.ResponseWriter, r *http.Request) {
+		authorization, clientID = r.Header.Get("Authorization"), r.Header.Get(headerKeyClientID)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c := NewClient(nil)
+	body := &models.ResourceReservationCallbackLinkJSONRequestBody{PoolId: "pool"}
+
+	t.Run("Authenticated with the callback credentials", func(t *testing.T) {
+		credentials := &models.CallbackCredentials{ClientId: "client", ClientSecret: "secret", TokenUrl: server.URL + "/oauth2/token"}
+		for range 2 {
+			require.NoError(t, c.NotifyResourceReservation(context.Background(), server.URL+"/callback", credentials, body))
+			require.Equal(t, "Bearer token", authorization)
+			require.Equal(t, "client", clientID)
+		}
+		require.Equal(t, 1, tokens)
+	})
+
+	t.Run("Without callback credentials", func(t *testing.T) {
+		require.NoError(t, c.NotifyResourceReservation(context.Background(), server.URL+"/callback", nil, body))
+		require.Empty(t, authorization)
+		require.Empty(t, clientID)
+	})
+}
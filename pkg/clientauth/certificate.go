@@ -0,0 +1,50 @@
+package clientauth
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// CertificateSubject maps the subject of a partner OP client certificate, in
+// its RFC 2253 string form (for example "CN=partner,O=Operator,C=ES"), to its
+// client id.
+type CertificateSubject struct {
+	Subject  string `json:"subject"`
+	ClientID string `json:"clientId"`
+}
+
+// CertificateSubjects are the client ids of the partner OPs by certificate subject.
+type CertificateSubjects map[string]string
+
+// LoadCertificateSubjects reads the certificate subjects of the partner OPs from
+// a JSON file holding a list of subjects.
+func LoadCertificateSubjects(path string) (CertificateSubjects, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read certificate subjects '%s'", path)
+	}
+	var list []CertificateSubject
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrapf(err, "invalid certificate subjects '%s'", path)
+	}
+	subjects := make(CertificateSubjects, len(list))
+	for _, s := range list {
+		if s.Subject == "" || s.ClientID == "" {
+			return nil, errors.Errorf("invalid certificate subjects '%s': every subject needs a client id", path)
+		}
+		if _, ok := subjects[s.Subject]; ok {
+			return nil, errors.Errorf("invalid certificate subjects '%s': subject '%s' is duplicated", path, s.Subject)
+		}
+		subjects[s.Subject] = s.ClientID
+	}
+	return subjects, nil
+}
+
+// ClientID returns the client id of the partner OP owning the verified certificate.
+func (s CertificateSubjects) ClientID(cert *x509.Certificate) (string, bool) {
+	id, ok := s[cert.Subject.String()]
+	return id, ok
+}
@@ -0,0 +1,65 @@
+package clientauth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+var _ TokenValidator = &jwtValidator{}
+
+// DefaultClientIDClaim is the claim holding the client id in OAuth2 JWT access tokens (RFC 9068).
+const DefaultClientIDClaim = "client_id"
+
+// NewJWTValidator validates JWT access tokens signed with one of the keys returned by keyfunc.
+// The token must not be expired and, when not empty, must be issued by issuer for audience.
+// The client id is read from clientIDClaim, DefaultClientIDClaim if empty.
+func NewJWTValidator(keyfunc jwt.Keyfunc, issuer, audience, clientIDClaim string) *jwtValidator {
+	if clientIDClaim == "" {
+		clientIDClaim = DefaultClientIDClaim
+	}
+	return &jwtValidator{keyfunc: keyfunc, issuer: issuer, audience: audience, clientIDClaim: clientIDClaim}
+}
+
+type jwtValidator struct {
+	keyfunc       jwt.Keyfunc
+	issuer        string
+	audience      string
+	clientIDClaim string
+}
+
+func (v *jwtValidator) ValidateToken(ctx context.Context, token string) (*Claims, error) {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+	if v.audience != "" {
+		opts = append(opts, jwt.WithAudience(v.audience))
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, v.keyfunc, opts...); err != nil {
+		return nil, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	clientID, _ := claims[v.clientIDClaim].(string)
+	if clientID == "" {
+		return nil, errors.Wrapf(ErrInvalidToken, "token has no '%s' claim", v.clientIDClaim)
+	}
+	return &Claims{ClientID: clientID, Scopes: scopes(claims)}, nil
+}
+
+// scopes reads the space separated "scope" claim (RFC 9068), or the "scp" list used by some providers.
+func scopes(claims jwt.MapClaims) []string {
+	if scope, ok := claims["scope"].(string); ok {
+		return strings.Fields(scope)
+	}
+	list, _ := claims["scp"].([]interface{})
+	scopes := make([]string, 0, len(list))
+	for _, s := range list {
+		if s, ok := s.(string); ok {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,81 @@
+package clientauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+func Test_jwtValidator_ValidateToken(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keysFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(keysFile, []byte(fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"idp","alg":"RS256","use":"sig","n":"%s","e":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))), 0o600))
+	keys, err := jwks.LoadFile(keysFile)
+	require.NoError(t, err)
+	validator := NewJWTValidator(keys.Keyfunc, "https://idp.example", "opg-ewbi-api", "")
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "idp"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+	valid := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":       "https://idp.example",
+			"aud":       []string{"opg-ewbi-api"},
+			"exp":       exp,
+			"client_id": "partner",
+			"scope":     "fed-mgmt zone-mgmt",
+		}
+	}
+	with := func(key string, value any) jwt.MapClaims {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	t.Run("Valid token", func(t *testing.T) {
+		claims, err := validator.ValidateToken(context.Background(), sign(valid()))
+		require.NoError(t, err)
+		require.Equal(t, &Claims{ClientID: "partner", Scopes: []string{"fed-mgmt", "zone-mgmt"}}, claims)
+	})
+
+	tests := []struct {
+		name   string
+		claims jwt.MapClaims
+	}{
+		{"Other issuer", with("iss", "https://other.example")},
+		{"Other audience", with("aud", "other-api")},
+		{"Expired token", with("exp", time.Now().Add(-time.Hour).Unix())},
+		{"No expiration", with("exp", nil)},
+		{"No client id", with("client_id", nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validator.ValidateToken(context.Background(), sign(tt.claims))
+			require.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
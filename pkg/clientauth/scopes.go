@@ -0,0 +1,46 @@
+package clientauth
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/routes"
+)
+
+// ScopeRequirements are the OAuth2 scopes the operations of an OpenAPI spec
+// require. An operation is allowed when the token grants every scope of at
+// least one of its security requirements.
+type ScopeRequirements struct {
+	operations *routes.Operations
+}
+
+// NewScopeRequirements checks the scopes against the security requirements
+// of the operations.
+func NewScopeRequirements(operations *routes.Operations) ScopeRequirements {
+	return ScopeRequirements{operations: operations}
+}
+
+// Missing returns the scopes, not in granted, of the first security requirement
+// of the operation served by the route, in the Echo format (/:param). It returns
+// nil when granted satisfies one of the requirements or when the operation has none.
+func (r ScopeRequirements) Missing(method, route string, granted []string) []string {
+	var missing []string
+	for _, requirement := range r.operations.Security(method, route) {
+		var m []string
+		for _, scopes := range requirement {
+			for _, s := range scopes {
+				if !slices.Contains(granted, s) {
+					m = append(m, s)
+				}
+			}
+		}
+		if len(m) == 0 {
+			return nil
+		}
+		if missing == nil {
+			sort.Strings(m)
+			missing = m
+		}
+	}
+	return missing
+}
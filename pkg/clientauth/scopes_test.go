@@ -0,0 +1,67 @@
+package clientauth
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/routes"
+)
+
+const scopesSpec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+security:
+  - oAuth2: [fed-mgmt]
+paths:
+  /partner:
+    post:
+      responses:
+        "200":
+          description: ok
+  /{federationContextId}/edgenodesharing/edgeDiscovery:
+    post:
+      security:
+        - oAuth2: [edge-discovery]
+        - oAuth2: [fed-mgmt, app-lcm]
+      responses:
+        "200":
+          description: ok
+  /public:
+    get:
+      security: []
+      responses:
+        "200":
+          description: ok
+`
+
+func Test_ScopeRequirements_Missing(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(scopesSpec))
+	require.NoError(t, err)
+	r := NewScopeRequirements(routes.New(swagger))
+
+	tests := []struct {
+		name    string
+		method  string
+		route   string
+		granted []string
+		missing []string
+	}{
+		{"Spec requirement granted", http.MethodPost, "/partner", []string{"fed-mgmt"}, nil},
+		{"Spec requirement missing", http.MethodPost, "/partner", []string{"edge-discovery"}, []string{"fed-mgmt"}},
+		{"First alternative granted", http.MethodPost, "/:federationContextId/edgenodesharing/edgeDiscovery", []string{"edge-discovery"}, nil},
+		{"Second alternative granted", http.MethodPost, "/:federationContextId/edgenodesharing/edgeDiscovery", []string{"app-lcm", "fed-mgmt"}, nil},
+		{"Alternatives partially granted", http.MethodPost, "/:federationContextId/edgenodesharing/edgeDiscovery", []string{"fed-mgmt"}, []string{"edge-discovery"}},
+		{"No requirement", http.MethodGet, "/public", nil, nil},
+		{"Unknown route", http.MethodGet, "/unknown", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.missing, r.Missing(tt.method, tt.route, tt.granted))
+		})
+	}
+}
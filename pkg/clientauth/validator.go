@@ -0,0 +1,37 @@
+package clientauth
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidToken = errors.New("invalid access token")
+
+// Claims identify the partner OP the access token was issued to.
+type Claims struct {
+	ClientID string
+	Scopes   []string
+}
+
+// TokenValidator validates the OAuth2 access tokens presented by partner OPs.
+type TokenValidator interface {
+	ValidateToken(ctx context.Context, token string) (*Claims, error)
+}
+
+// Validators accept the tokens that any of them accepts, trying them in order.
+type Validators []TokenValidator
+
+func (v Validators) ValidateToken(ctx context.Context, token string) (*Claims, error) {
+	var errs []error
+	for _, validator := range v {
+		claims, err := validator.ValidateToken(ctx, token)
+		if err == nil {
+			return claims, nil
+		}
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		return nil, ErrInvalidToken
+	}
+	return nil, errors.Join(errs...)
+}
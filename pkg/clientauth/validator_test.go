@@ -0,0 +1,60 @@
+package clientauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+func Test_Validators_ValidateToken(t *testing.T) {
+	type issuer struct {
+		name string
+		key  *rsa.PrivateKey
+	}
+	newIssuer := func(name string) issuer {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		return issuer{name: name, key: key}
+	}
+	validator := func(i issuer, audience string) TokenValidator {
+		keys := &jwks.KeySet{Keys: []jwks.Key{{ID: i.name, Algorithm: "RS256", Key: &i.key.PublicKey}}}
+		return NewJWTValidator(keys.Keyfunc, i.name, audience, "client_id")
+	}
+	sign := func(i issuer, audience string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":       i.name,
+			"aud":       audience,
+			"exp":       time.Now().Add(time.Hour).Unix(),
+			"client_id": i.name + "-partner",
+		})
+		token.Header["kid"] = i.name
+		signed, err := token.SignedString(i.key)
+		require.NoError(t, err)
+		return signed
+	}
+
+	tokenServer, idp, other := newIssuer("token-server"), newIssuer("idp"), newIssuer("other")
+	validators := Validators{validator(tokenServer, "ewbi"), validator(idp, "opg-ewbi-api")}
+
+	claims, err := validators.ValidateToken(context.Background(), sign(tokenServer, "ewbi"))
+	require.NoError(t, err)
+	require.Equal(t, "token-server-partner", claims.ClientID)
+	claims, err = validators.ValidateToken(context.Background(), sign(idp, "opg-ewbi-api"))
+	require.NoError(t, err)
+	require.Equal(t, "idp-partner", claims.ClientID)
+
+	// each issuer is checked with its own audience
+	_, err = validators.ValidateToken(context.Background(), sign(idp, "ewbi"))
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = validators.ValidateToken(context.Background(), sign(other, "ewbi"))
+	require.ErrorIs(t, err, ErrInvalidToken)
+	_, err = Validators{}.ValidateToken(context.Background(), sign(idp, "opg-ewbi-api"))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
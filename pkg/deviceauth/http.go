@@ -0,0 +1,85 @@
+package deviceauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/partnerclient"
+)
+
+var _ DeviceTokenVerifier = &httpVerifier{}
+
+// CredentialsFunc returns the credentials to authenticate with to the home
+// OP of the devices of a federation, nil when it provided none.
+type CredentialsFunc func(ctx context.Context, federationContextID string) (*models.CallbackCredentials, error)
+
+// NewHTTPVerifier verifies device tokens asking the home OP. The urlTemplate
+// placeholders {federationContextId} and {deviceId} are replaced with the
+// escaped request values, and the token is sent in the body of a POST request,
+// authenticated with an access token obtained with the credentials, as the
+// callbacks are. The home OP answers 200 for a valid token, 403 for an invalid
+// one and 404 for an unknown device; 401 means it rejected the credentials.
+func NewHTTPVerifier(urlTemplate string, credentials CredentialsFunc, httpClient *http.Client) *httpVerifier {
+	return &httpVerifier{
+		urlTemplate: urlTemplate,
+		credentials: credentials,
+		httpClients: partnerclient.NewHTTPClients(httpClient),
+	}
+}
+
+type httpVerifier struct {
+	urlTemplate string
+	credentials CredentialsFunc
+	httpClients *partnerclient.HTTPClients
+}
+
+// verifyRequest is the body of the verification requests.
+type verifyRequest struct {
+	FederationContextId string `json:"federationContextId"`
+	DeviceId            string `json:"deviceId"`
+	AuthToken           string `json:"authToken"`
+}
+
+func (v *httpVerifier) VerifyDeviceToken(ctx context.Context, federationContextID, deviceID, token string) error {
+	credentials, err := v.credentials(ctx, federationContextID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get home OP credentials")
+	}
+	link := strings.NewReplacer(
+		"{federationContextId}", url.PathEscape(federationContextID),
+		"{deviceId}", url.PathEscape(deviceID),
+	).Replace(v.urlTemplate)
+	payload, err := json.Marshal(verifyRequest{FederationContextId: federationContextID, DeviceId: deviceID, AuthToken: token})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal verification request")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, link, bytes.NewReader(payload))
+	if err != nil {
+		return errors.Wrap(err, "invalid home OP verification url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := v.httpClients.Get(credentials).Do(req)
+	if err != nil {
+		return errors.Wrap(err, "failed to reach home OP")
+	}
+	defer res.Body.Close()
+
+	switch {
+	case res.StatusCode >= 200 && res.StatusCode < 300:
+		return nil
+	case res.StatusCode == http.StatusForbidden:
+		return ErrInvalidToken
+	case res.StatusCode == http.StatusNotFound:
+		return ErrUnknownDevice
+	}
+	return fmt.Errorf("home OP returned unexpected status %d", res.StatusCode)
+}
@@ -0,0 +1,74 @@
+package deviceauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func Test_httpVerifier_VerifyDeviceToken(t *testing.T) {
+	tokens := map[string]string{"device": "valid"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+	})
+	mux.HandleFunc("POST /{federationContextId}/devices/{deviceId}/verify", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var body verifyRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		require.Equal(t, "federation", r.PathValue("federationContextId"))
+		require.Equal(t, r.PathValue("deviceId"), body.DeviceId)
+		token, ok := tokens[body.DeviceId]
+		switch {
+		case !ok:
+			w.WriteHeader(http.StatusNotFound)
+		case token != body.AuthToken:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	credentials := &models.CallbackCredentials{ClientId: "client", ClientSecret: "secret", TokenUrl: server.URL + "/oauth2/token"}
+	verifier := NewHTTPVerifier(server.URL+"/{federationContextId}/devices/{deviceId}/verify", func(ctx context.Context, federationContextID string) (*models.CallbackCredentials, error) {
+		return credentials, nil
+	}, nil)
+
+	tests := []struct {
+		name     string
+		deviceID string
+		token    string
+		err      error
+	}{
+		{"Valid token", "device", "valid", nil},
+		{"Invalid token", "device", "invalid", ErrInvalidToken},
+		{"Unknown device", "other", "valid", ErrUnknownDevice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.VerifyDeviceToken(context.Background(), "federation", tt.deviceID, tt.token)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+
+	t.Run("Without credentials", func(t *testing.T) {
+		credentials = nil
+		err := verifier.VerifyDeviceToken(context.Background(), "federation", "device", "valid")
+		require.ErrorContains(t, err, "status 401")
+		require.NotErrorIs(t, err, ErrInvalidToken)
+	})
+}
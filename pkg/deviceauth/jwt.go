@@ -0,0 +1,39 @@
+package deviceauth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+var _ DeviceTokenVerifier = &jwtVerifier{}
+
+// NewJWTVerifier verifies device tokens signed by the home OP with one of the keys.
+// The token must not be expired and its subject must be the device id.
+// When issuer is not empty the token must also be issued by it.
+func NewJWTVerifier(keys *jwks.KeySet, issuer string) *jwtVerifier {
+	return &jwtVerifier{keys: keys, issuer: issuer}
+}
+
+type jwtVerifier struct {
+	keys   *jwks.KeySet
+	issuer string
+}
+
+func (v *jwtVerifier) VerifyDeviceToken(ctx context.Context, federationContextID, deviceID, token string) error {
+	opts := []jwt.ParserOption{jwt.WithExpirationRequired()}
+	if v.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(v.issuer))
+	}
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, v.keys.Keyfunc, opts...); err != nil {
+		return errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if sub, _ := claims["sub"].(string); sub != deviceID {
+		return errors.Wrap(ErrInvalidToken, "token was not issued to the device")
+	}
+	return nil
+}
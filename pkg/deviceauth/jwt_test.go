@@ -0,0 +1,54 @@
+package deviceauth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+func Test_jwtVerifier_VerifyDeviceToken(t *testing.T) {
+	secret := []byte("home-op-shared-secret")
+	keys, err := jwks.Parse([]byte(fmt.Sprintf(`{"keys":[{"kty":"oct","kid":"home","k":"%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(secret))))
+	require.NoError(t, err)
+	verifier := NewJWTVerifier(keys, "home-op")
+
+	sign := func(claims jwt.MapClaims, key []byte) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		token.Header["kid"] = "home"
+		signed, err := token.SignedString(key)
+		require.NoError(t, err)
+		return signed
+	}
+	exp := time.Now().Add(time.Hour).Unix()
+
+	tests := []struct {
+		name  string
+		token string
+		err   error
+	}{
+		{"Valid token", sign(jwt.MapClaims{"sub": "device", "iss": "home-op", "exp": exp}, secret), nil},
+		{"Other device", sign(jwt.MapClaims{"sub": "other", "iss": "home-op", "exp": exp}, secret), ErrInvalidToken},
+		{"Other issuer", sign(jwt.MapClaims{"sub": "device", "iss": "other-op", "exp": exp}, secret), ErrInvalidToken},
+		{"Expired token", sign(jwt.MapClaims{"sub": "device", "iss": "home-op", "exp": time.Now().Add(-time.Hour).Unix()}, secret), ErrInvalidToken},
+		{"No expiration", sign(jwt.MapClaims{"sub": "device", "iss": "home-op"}, secret), ErrInvalidToken},
+		{"Wrong key", sign(jwt.MapClaims{"sub": "device", "iss": "home-op", "exp": exp}, []byte("other-secret")), ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifier.VerifyDeviceToken(context.Background(), "federation", "device", tt.token)
+			if tt.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
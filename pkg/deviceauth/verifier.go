@@ -0,0 +1,15 @@
+package deviceauth
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrInvalidToken = errors.New("invalid device token")
+var ErrUnknownDevice = errors.New("unknown device")
+
+// DeviceTokenVerifier confirms that the token presented by a roaming device
+// was issued to it by its home OP.
+type DeviceTokenVerifier interface {
+	VerifyDeviceToken(ctx context.Context, federationContextID, deviceID, token string) error
+}
@@ -1,17 +1,119 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
 	"github.com/neonephos-katalis/opg-ewbi-api/pkg/uuid"
 )
 
+var errForbidden = errors.New("forbidden")
+
+const (
+	paramFederationCallbackID = "federationCallbackId"
+	paramFederationContextID  = "federationContextId"
+)
+
+const (
+	bearerPrefix                  = "Bearer "
+	contextKeyClaims              = "clientauth.claims"
+	contextKeyCertificateClientID = "clientauth.certificateClientId"
+	wwwAuthBearer                 = "Bearer"
+	wwwAuthBadBearer              = `Bearer error="invalid_token"`
+)
+
+// ValidateAuthHeaders authenticates the partner OP with the bearer access token
+// of the request and keeps its claims in the context.
+// When certificate subjects are registered, the partner OP must also present a
+// registered client certificate, and the token must be issued to the same client.
+// Without a token validator every other request is accepted and the client id
+// is taken from the certificate or the X-Client-ID header.
 func (h *handler) ValidateAuthHeaders(c echo.Context) (statusCode int, err error) {
+	certificateClientID := ""
+	if h.certificateSubjects != nil {
+		state := c.Request().TLS
+		if state == nil || len(state.VerifiedChains) == 0 {
+			return http.StatusUnauthorized, errors.New("missing client certificate")
+		}
+		cert := state.VerifiedChains[0][0]
+		var ok bool
+		if certificateClientID, ok = h.certificateSubjects.ClientID(cert); !ok {
+			return http.StatusUnauthorized, fmt.Errorf("client certificate subject '%s' is not registered", cert.Subject)
+		}
+		c.Set(contextKeyCertificateClientID, certificateClientID)
+	}
+	if h.tokenValidator == nil {
+		return http.StatusAccepted, nil
+	}
+
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	if len(header) <= len(bearerPrefix) || !strings.EqualFold(header[:len(bearerPrefix)], bearerPrefix) {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, wwwAuthBearer)
+		return http.StatusUnauthorized, errors.New("missing bearer token")
+	}
+	claims, err := h.tokenValidator.ValidateToken(h.getRequestContextFunc(c), header[len(bearerPrefix):])
+	if err != nil {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, wwwAuthBadBearer)
+		return http.StatusUnauthorized, err
+	}
+	if certificateClientID != "" && claims.ClientID != certificateClientID {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, wwwAuthBadBearer)
+		return http.StatusUnauthorized, fmt.Errorf("access token was not issued to the client certificate owner '%s'", certificateClientID)
+	}
+	c.Set(contextKeyClaims, claims)
 	return http.StatusAccepted, nil
 }
 
+// authorizeFederation ensures the federation of the request, identified by its
+// context id or its callback id, belongs to the requesting partner OP.
+func (h *handler) authorizeFederation(c echo.Context) error {
+	ctx := h.getRequestContextFunc(c)
+
+	var owner string
+	var err error
+	if id := c.Param(paramFederationContextID); id != "" {
+		owner, err = h.metaStoreClient.GetFederationClientID(ctx, id)
+	} else if id := c.Param(paramFederationCallbackID); id != "" {
+		owner, err = h.metaStoreClient.GetGuestFederationClientID(ctx, id)
+	} else {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	userClientCredentials, err := h.getRequestClientCredentialsFunc(c)
+	if err != nil {
+		return fmt.Errorf("%w: %s", metastore.ErrUnauthorized, err.Error())
+	}
+	if owner == "" || owner != userClientCredentials.ClientID {
+		return fmt.Errorf("%w: federation does not belong to client '%s'", errForbidden, userClientCredentials.ClientID)
+	}
+	return nil
+}
+
+// getRequestClaims returns the claims of the access token validated for the request, if any.
+func getRequestClaims(c echo.Context) (*clientauth.Claims, bool) {
+	claims, ok := c.Get(contextKeyClaims).(*clientauth.Claims)
+	return claims, ok
+}
+
+// getAuthenticatedClientID returns the client id of the validated access token
+// or of the registered client certificate of the request, if any.
+func getAuthenticatedClientID(c echo.Context) (string, bool) {
+	if claims, ok := getRequestClaims(c); ok {
+		return claims.ClientID, true
+	}
+	clientID, ok := c.Get(contextKeyCertificateClientID).(string)
+	return clientID, ok
+}
+
 func (h *handler) generateFederationContextID(c echo.Context) string {
 	userClientCredentials, _ := h.getRequestClientCredentialsFunc(c)
 	return uuid.V5(userClientCredentials.ClientID)
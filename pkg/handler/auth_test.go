@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore/mock"
+)
+
+func Test_authorizeFederation(t *testing.T) {
+	owners := map[string]string{"fed": "partner", "unlabelled": ""}
+	lookup := func(id string) (string, error) {
+		owner, ok := owners[id]
+		if !ok {
+			return "", fmt.Errorf("federation '%s' %w", id, metastore.ErrNotFound)
+		}
+		return owner, nil
+	}
+	var guestLookups []string
+	h := &handler{
+		getRequestClientCredentialsFunc: func(c echo.Context) (metastore.ClientCredentials, error) {
+			return metastore.ClientCredentials{ClientID: c.Request().Header.Get(headerKeyClientID)}, nil
+		},
+		getRequestContextFunc: func(echo.Context) context.Context { return context.Background() },
+		metaStoreClient: &mock.FakeMetaStoreClient{
+			GetFederationClientIDFunc: lookup,
+			GetGuestFederationClientIDFunc: func(federationCallbackID string) (string, error) {
+				guestLookups = append(guestLookups, federationCallbackID)
+				return lookup(federationCallbackID)
+			},
+		},
+	}
+
+	e := echo.New()
+	e.Use(AuthorizationMiddleware(h))
+	ok := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	e.GET("/:federationContextId/partner", ok)
+	e.POST("/:federationCallbackId/appStatusCallbackLink", ok)
+	e.POST("/partner", ok)
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		clientID string
+		status   int
+	}{
+		{"Matching owner", http.MethodGet, "/fed/partner", "partner", http.StatusOK},
+		{"Mismatched owner", http.MethodGet, "/fed/partner", "other", http.StatusForbidden},
+		{"Missing owner label", http.MethodGet, "/unlabelled/partner", "", http.StatusForbidden},
+		{"Federation not found", http.MethodGet, "/unknown/partner", "partner", http.StatusNotFound},
+		{"Matching guest federation owner", http.MethodPost, "/fed/appStatusCallbackLink", "partner", http.StatusOK},
+		{"Mismatched guest federation owner", http.MethodPost, "/fed/appStatusCallbackLink", "other", http.StatusForbidden},
+		{"No federation", http.MethodPost, "/partner", "other", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set(headerKeyClientID, tt.clientID)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+		})
+	}
+	require.Equal(t, []string{"fed", "fed"}, guestLookups)
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
 )
 
 // Notification payload.
@@ -75,7 +76,17 @@ func (h *handler) AppInstCallbackLink(c echo.Context, federationCallbackId model
 // Notification about resource availability.
 // (POST /{federationCallbackId}/availZoneNotifLink)
 func (h *handler) AvailZoneNotifLink(c echo.Context, federationCallbackId models.FederationCallbackId) error {
-	return c.JSON(http.StatusNotImplemented, nil)
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.AvailZoneNotifLinkJSONRequestBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.metaStoreClient.UpdateZoneResources(ctx, federationCallbackId, request); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	return c.JSON(http.StatusOK, nil)
 }
 
 // OP uses this callback api to notify partner OP about change in federation status, federation metadata or offered zone details. Allowed combinations of objectType and operationType are
@@ -112,6 +123,18 @@ func (h *handler) PartnerStatusLink(c echo.Context, federationCallbackId models.
 		if err := h.metaStoreClient.UpdateFederationStatus(ctx, federationCallbackId, *request.FederationStatus); err != nil {
 			return sendErrorResponseFromError(c, err)
 		}
+	case models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+		models.PartnerStatusLinkJSONBodyObjectTypeEDGEDISCOVERYSERVICE,
+		models.PartnerStatusLinkJSONBodyObjectTypeLCMSERVICE,
+		models.PartnerStatusLinkJSONBodyObjectTypeMOBILENETWORKCODES,
+		models.PartnerStatusLinkJSONBodyObjectTypeFIXEDNETWORKCODES:
+		update := &metastore.UpdatePartnerStatus{
+			PartnerStatusLinkJSONRequestBody: request,
+			FederationCallbackId:             federationCallbackId,
+		}
+		if err := h.metaStoreClient.UpdatePartnerStatus(ctx, update); err != nil {
+			return sendErrorResponseFromError(c, err)
+		}
 	default:
 		return sendErrorResponse(c, http.StatusNotImplemented, "ObjectType not implemented")
 	}
@@ -122,5 +145,15 @@ func (h *handler) PartnerStatusLink(c echo.Context, federationCallbackId models.
 // Notification payload.
 // (POST /{federationCallbackId}/resourceReservationCallbackLink)
 func (h *handler) ResourceReservationCallbackLink(c echo.Context, federationCallbackId models.FederationCallbackId) error {
-	return c.JSON(http.StatusNotImplemented, nil)
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.ResourceReservationCallbackLinkJSONRequestBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.metaStoreClient.UpdateResourcePoolReservationGrant(ctx, federationCallbackId, request); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	return c.JSON(http.StatusNoContent, nil)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/deviceauth"
 	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
 )
 
@@ -35,10 +36,18 @@ func statusCodeFromError(err error) int {
 		return http.StatusConflict
 	case errors.Is(err, metastore.ErrBadRequest):
 		return http.StatusBadRequest
+	case errors.Is(err, metastore.ErrConflict):
+		return http.StatusConflict
 	case errors.Is(err, metastore.ErrNotFound):
 		return http.StatusNotFound
 	case errors.Is(err, metastore.ErrUnauthorized):
 		return http.StatusUnauthorized
+	case errors.Is(err, errForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, deviceauth.ErrInvalidToken):
+		return http.StatusUnauthorized
+	case errors.Is(err, deviceauth.ErrUnknownDevice):
+		return http.StatusNotFound
 	default:
 		return http.StatusInternalServerError
 	}
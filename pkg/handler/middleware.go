@@ -1,14 +1,32 @@
 package handler
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/labstack/echo/v4"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/audit"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/ratelimit"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/routes"
 )
 
+// inFlightRetryAfter is the delay suggested when too many mutating requests are in flight.
+const inFlightRetryAfter = time.Second
+
 // AuthMiddleware ensures that every request has valid authentication headers.
 func AuthMiddleware(h *handler) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// TODO: Integration with IdP
 			if errCode, err := h.ValidateAuthHeaders(c); err != nil {
 				return sendErrorResponse(c, errCode, err.Error())
 			}
@@ -16,3 +34,165 @@ func AuthMiddleware(h *handler) echo.MiddlewareFunc {
 		}
 	}
 }
+
+// AuthorizationMiddleware ensures that the partner OP only accesses its own federation.
+func AuthorizationMiddleware(h *handler) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if err := h.authorizeFederation(c); err != nil {
+				return sendErrorResponseFromError(c, err)
+			}
+			return next(c)
+		}
+	}
+}
+
+// ScopeMiddleware ensures that the access token grants the scopes the spec
+// requires for the requested operation. Requests are not checked when they
+// are not authenticated with an access token.
+func ScopeMiddleware(operations *routes.Operations) echo.MiddlewareFunc {
+	required := clientauth.NewScopeRequirements(operations)
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims, ok := getRequestClaims(c)
+			if !ok {
+				return next(c)
+			}
+			if missing := required.Missing(c.Request().Method, c.Path(), claims.Scopes); missing != nil {
+				scope := strings.Join(missing, " ")
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, fmt.Sprintf(`Bearer error="insufficient_scope", scope="%s"`, scope))
+				return sendErrorResponse(c, http.StatusForbidden, fmt.Sprintf("access token is missing scope '%s'", scope))
+			}
+			return next(c)
+		}
+	}
+}
+
+// RateLimitMiddleware limits the requests of each partner OP, by client id, and
+// the mutating requests it runs at the same time on each federation. Requests
+// over the limits are answered with 429 and the delay after which to retry.
+func RateLimitMiddleware(h *handler, limiter *ratelimit.Limiter, operations *routes.Operations) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// requests without credentials share the limits of an empty client id
+			userClientCredentials, _ := h.getRequestClientCredentialsFunc(c)
+			clientID := userClientCredentials.ClientID
+
+			if wait, ok := limiter.Allow(clientID, operations.ID(c.Request().Method, c.Path())); !ok {
+				return sendTooManyRequests(c, wait, "rate limit exceeded")
+			}
+			switch c.Request().Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+				federation := c.Param(paramFederationContextID)
+				if federation == "" {
+					federation = c.Param(paramFederationCallbackID)
+				}
+				release, ok := limiter.Acquire(clientID, federation)
+				if !ok {
+					return sendTooManyRequests(c, inFlightRetryAfter, "too many requests in progress for the federation")
+				}
+				defer release()
+			}
+			return next(c)
+		}
+	}
+}
+
+// AuditMiddleware records the mutating requests and the callbacks of the
+// partner OPs, with their outcome. It must run before the request validation
+// and the authentication so that the rejected requests are recorded too.
+// The client id is recorded only when the request was authenticated, with an
+// access token or a client certificate, as the X-Client-ID header is not verified.
+func AuditMiddleware(h *handler, logger *audit.Logger, operations *routes.Operations) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			callback := c.Param(paramFederationCallbackID) != ""
+			switch c.Request().Method {
+			case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+			default:
+				if !callback {
+					return next(c)
+				}
+			}
+
+			targets := requestBodyTargets(c)
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				status = http.StatusInternalServerError
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) {
+					status = httpErr.Code
+				}
+			}
+			outcome := audit.OutcomeSuccess
+			if status >= http.StatusBadRequest {
+				outcome = audit.OutcomeFailure
+			}
+
+			clientID, _ := getAuthenticatedClientID(c)
+			record := audit.Record{
+				Time:                 time.Now().UTC(),
+				ClientID:             clientID,
+				FederationContextID:  c.Param(paramFederationContextID),
+				FederationCallbackID: c.Param(paramFederationCallbackID),
+				Operation:            operations.ID(c.Request().Method, c.Path()),
+				Method:               c.Request().Method,
+				Path:                 c.Request().URL.Path,
+				Status:               status,
+				Outcome:              outcome,
+			}
+			if record.Operation == "CreateFederation" && outcome == audit.OutcomeSuccess {
+				record.FederationContextID = h.generateFederationContextID(c)
+			}
+			for i, name := range c.ParamNames() {
+				if name != paramFederationContextID && name != paramFederationCallbackID {
+					targets[name] = c.ParamValues()[i]
+				}
+			}
+			if form := c.Request().MultipartForm; form != nil {
+				for name, values := range form.Value {
+					if strings.HasSuffix(name, "Id") && len(values) > 0 {
+						targets[name] = values[0]
+					}
+				}
+			}
+			if len(targets) > 0 {
+				record.Targets = targets
+			}
+			logger.Log(record)
+			return err
+		}
+	}
+}
+
+// requestBodyTargets returns the top level ids of a JSON request body, leaving
+// the body unread for the next handlers.
+func requestBodyTargets(c echo.Context) map[string]string {
+	targets := map[string]string{}
+	req := c.Request()
+	if req.Body == nil || !strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		return targets
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return targets
+	}
+	var fields map[string]any
+	if json.Unmarshal(body, &fields) != nil {
+		return targets
+	}
+	for name, value := range fields {
+		if id, ok := value.(string); ok && strings.HasSuffix(name, "Id") {
+			targets[name] = id
+		}
+	}
+	return targets
+}
+
+func sendTooManyRequests(c echo.Context, wait time.Duration, detail string) error {
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+	return sendErrorResponse(c, http.StatusTooManyRequests, detail)
+}
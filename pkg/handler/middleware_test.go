@@ -0,0 +1,99 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/server"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/audit"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/routes"
+)
+
+type recordSink []audit.Record
+
+func (s *recordSink) Write(entry audit.Entry) error {
+	var record audit.Record
+	if err := json.Unmarshal(entry.Record, &record); err != nil {
+		return err
+	}
+	*s = append(*s, record)
+	return nil
+}
+
+func Test_AuditMiddleware(t *testing.T) {
+	h := &handler{getRequestClientCredentialsFunc: getRequestClientCredentials}
+	sink := &recordSink{}
+
+	swagger := server.Swagger()
+	e := echo.New()
+	e.Use(AuditMiddleware(h, audit.NewLogger(nil, sink), routes.New(swagger)))
+	e.Use(server.Validator(swagger))
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if clientID := c.Request().Header.Get("Authorization"); clientID != "" {
+				c.Set(contextKeyClaims, &clientauth.Claims{ClientID: clientID})
+			}
+			return next(c)
+		}
+	})
+	e.PATCH("/:federationContextId/partner", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+
+	tests := []struct {
+		name     string
+		header   string
+		value    string
+		body     string
+		clientID string
+		status   int
+	}{
+		{
+			name:     "Authenticated client",
+			header:   "Authorization",
+			value:    "client",
+			body:     `{"objectType":"MOBILE_NETWORK_CODES","operationType":"ADD_CODES","modificationDate":"2024-01-01T00:00:00Z"}`,
+			clientID: "client",
+			status:   http.StatusOK,
+		},
+		{
+			name:   "Unverified client id header",
+			header: headerKeyClientID,
+			value:  "client",
+			body:   `{"objectType":"MOBILE_NETWORK_CODES","operationType":"ADD_CODES","modificationDate":"2024-01-01T00:00:00Z"}`,
+			status: http.StatusOK,
+		},
+		{
+			name:   "Invalid request",
+			header: headerKeyClientID,
+			value:  "client",
+			body:   `{}`,
+			status: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			*sink = nil
+			req := httptest.NewRequest(http.MethodPatch, "/fed/partner", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Header.Set(tt.header, tt.value)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tt.status, rec.Code, rec.Body.String())
+			require.Len(t, *sink, 1)
+			record := (*sink)[0]
+			require.Equal(t, tt.clientID, record.ClientID)
+			require.Equal(t, "UpdateFederation", record.Operation)
+			require.Equal(t, "fed", record.FederationContextID)
+			require.Equal(t, tt.status, record.Status)
+		})
+	}
+}
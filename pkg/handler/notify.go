@@ -0,0 +1,51 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+)
+
+const callbackTimeout = time.Minute
+
+// notifyResourceReservation informs the originating OP of the flavours granted for the pool.
+// Resources are reserved as requested, so the notification is sent in the background
+// once the pool has been stored.
+func (h *handler) notifyResourceReservation(ctx context.Context, pool *metastore.ResourcePool) {
+	credentials, err := h.metaStoreClient.GetPartnerCallbackCredentials(ctx, pool.FederationContextId)
+	if err != nil {
+		log.WithError(err).Errorf("unable to notify reservation of resource pool '%s'", pool.PoolId)
+		return
+	}
+
+	body := &models.ResourceReservationCallbackLinkJSONRequestBody{
+		AppProviderId:       pool.AppProviderId,
+		FederationContextId: &pool.FederationContextId,
+		PoolId:              pool.PoolId,
+		ZoneId:              pool.ZoneId,
+	}
+	for _, flavour := range pool.Flavours {
+		body.GrantedFlavours = append(body.GrantedFlavours, struct {
+			// FlavourId An identifier to refer to a specific combination of compute resources.
+			FlavourId models.FlavourId `json:"flavourId"`
+
+			// NumFlavour Count of flavour
+			NumFlavour int32 `json:"numFlavour"`
+		}{
+			FlavourId:  flavour.FlavourId,
+			NumFlavour: flavour.NumFlavour,
+		})
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
+		defer cancel()
+		if err := h.callbackClient.NotifyResourceReservation(ctx, pool.ResourceReservationCallbackLink, credentials, body); err != nil {
+			log.WithError(err).Errorf("failed to notify reservation of resource pool '%s'", pool.PoolId)
+		}
+	}()
+}
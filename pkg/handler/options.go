@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/callback"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/deviceauth"
+)
+
+// Option configures optional behaviour of the handler.
+type Option func(*handler)
+
+// WithLatencyServiceEndpoint sets the endpoint returned to the originating OP
+// to measure the latency towards the candidate zones.
+func WithLatencyServiceEndpoint(endpoint *models.ServiceEndpoint) Option {
+	return func(h *handler) {
+		h.latencyServiceEndpoint = endpoint
+	}
+}
+
+// WithServiceEndpoint sets the endpoint of this API, returned to the
+// originating OP as the edge discovery and LCM service endpoints.
+func WithServiceEndpoint(endpoint *models.ServiceEndpoint) Option {
+	return func(h *handler) {
+		h.serviceEndpoint = endpoint
+	}
+}
+
+// NewServiceEndpoint builds a ServiceEndpoint from a "host:port" address.
+// The host may be an IPv4 address, an IPv6 address or a FQDN.
+func NewServiceEndpoint(addr string) (*models.ServiceEndpoint, error) {
+	host, p, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid service endpoint '%s'", addr)
+	}
+	port, err := strconv.Atoi(p)
+	if err != nil || port <= 0 || port > 65535 {
+		return nil, errors.Errorf("invalid port in service endpoint '%s'", addr)
+	}
+	endpoint := &models.ServiceEndpoint{Port: port}
+	switch ip := net.ParseIP(host); {
+	case ip == nil:
+		endpoint.Fqdn = &host
+	case ip.To4() != nil:
+		endpoint.Ipv4Addresses = &[]models.Ipv4Addr{host}
+	default:
+		endpoint.Ipv6Addresses = &[]models.Ipv6Addr{host}
+	}
+	return endpoint, nil
+}
+
+// WithCallbackClient sets the client used to notify the originating OP.
+func WithCallbackClient(client callback.Client) Option {
+	return func(h *handler) {
+		h.callbackClient = client
+	}
+}
+
+// WithDeviceTokenVerifier sets the verifier of the tokens presented by roaming devices.
+func WithDeviceTokenVerifier(verifier deviceauth.DeviceTokenVerifier) Option {
+	return func(h *handler) {
+		h.deviceTokenVerifier = verifier
+	}
+}
+
+// WithTokenValidator sets the validator of the access tokens presented by partner OPs.
+// The client id of the requests is then taken from the token instead of the X-Client-ID header.
+func WithTokenValidator(validator clientauth.TokenValidator) Option {
+	return func(h *handler) {
+		h.tokenValidator = validator
+	}
+}
+
+// WithCertificateSubjects sets the client ids of the partner OPs by the subject of
+// their client certificate. Requests must then present a registered certificate.
+func WithCertificateSubjects(subjects clientauth.CertificateSubjects) Option {
+	return func(h *handler) {
+		h.certificateSubjects = subjects
+	}
+}
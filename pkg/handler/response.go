@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/server"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+)
+
+// viewApplicationResponse extends the application details with the onboarding
+// state of each deployment zone. The specification has no field for it, so it
+// is returned in the x-statusInfo extension field, which clients following
+// the specification ignore.
+type viewApplicationResponse struct {
+	*server.ViewApplication200JSONResponse
+	StatusInfo []metastore.ApplicationZone `json:"x-statusInfo"`
+}
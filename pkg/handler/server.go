@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -10,7 +11,10 @@ import (
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/server"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/callback"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
 	"github.com/neonephos-katalis/opg-ewbi-api/pkg/deployment"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/deviceauth"
 	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
 )
 
@@ -20,14 +24,19 @@ const (
 	headerKeyClientID = "X-Client-ID"
 )
 
-func NewServer(apiRoot string, k8sClient client.Client, namespace string) *handler {
-	return &handler{
+func NewServer(apiRoot string, k8sClient client.Client, namespace string, opts ...Option) *handler {
+	h := &handler{
 		apiRoot:                         apiRoot,
+		callbackClient:                  callback.NewClient(nil),
 		depClient:                       deployment.NewClient(k8sClient, namespace),
 		getRequestClientCredentialsFunc: getRequestClientCredentials,
 		getRequestContextFunc:           getRequestContext,
 		metaStoreClient:                 metastore.NewK8sClient(k8sClient, namespace),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 type handler struct {
@@ -35,7 +44,13 @@ type handler struct {
 	depClient                       deployment.Client
 	getRequestClientCredentialsFunc func(echo.Context) (metastore.ClientCredentials, error) // test purposes
 	getRequestContextFunc           func(echo.Context) context.Context                      // test purposes
+	callbackClient                  callback.Client
+	certificateSubjects             clientauth.CertificateSubjects
+	deviceTokenVerifier             deviceauth.DeviceTokenVerifier
+	latencyServiceEndpoint          *models.ServiceEndpoint
 	metaStoreClient                 metastore.Client
+	serviceEndpoint                 *models.ServiceEndpoint
+	tokenValidator                  clientauth.TokenValidator
 }
 
 func (h *handler) CreateFederation(c echo.Context) error {
@@ -96,10 +111,54 @@ func (h *handler) RemoveApp(c echo.Context, federationContextId models.Federatio
 	return c.JSON(http.StatusOK, nil)
 }
 
+// Edge discovery procedures towards partner OP over E/WBI.
+// Originating OP requests partner OP to provide a list of candidate zones
+// where an application instance can be created. Partner OP applies a set
+// of filtering criteria to select candidate zones.
+// (POST /{federationContextId}/edgenodesharing/edgeDiscovery)
+func (h *handler) GetCandidateZones(c echo.Context, federationContextId models.FederationContextId) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.GetCandidateZonesJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+	if h.latencyServiceEndpoint == nil {
+		return sendErrorResponse(c, http.StatusInternalServerError, "latency service endpoint not configured")
+	}
+
+	var location *models.GeoLocation
+	if request.EdgeDiscoveryFilters != nil && request.EdgeDiscoveryFilters.Location != nil {
+		location = request.EdgeDiscoveryFilters.Location.GeoLocation
+	}
+	zones, err := h.metaStoreClient.ListCandidateZones(ctx, federationContextId, request.AppId, request.AppProviderId, location)
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	res := make(server.GetCandidateZones200JSONResponse, len(zones))
+	for i, zone := range zones {
+		res[i].ZoneId = zone.ZoneId
+		res[i].LatencyServiceEndPoints = *h.latencyServiceEndpoint
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// Retrieves all application instances of partner OP
+// (GET /{federationContextId}/application/lcm/app/{appId}/appProvider/{appProviderId})
+func (h *handler) GetAllAppInstances(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, appProviderId models.AppProviderId) error {
+	appInstances, err := h.metaStoreClient.ListApplicationInstances(h.getRequestContextFunc(c), federationContextId, appId, appProviderId)
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, appInstances.GetAllAppInstances200JSONResponse)
+}
+
 // Retrieves an application instance details from partner OP.
 // (GET /{federationContextId}/application/lcm/app/{appId}/instance/{appInstanceId}/zone/{zoneId})
 func (h *handler) GetAppInstanceDetails(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, appInstanceId models.InstanceIdentifier, zoneId models.ZoneIdentifier) error {
-	appInst, err := h.metaStoreClient.GetApplicationInstanceDetails(h.getRequestContextFunc(c), federationContextId, appInstanceId)
+	appInst, err := h.metaStoreClient.GetApplicationInstanceDetails(h.getRequestContextFunc(c), federationContextId, appId, appInstanceId, zoneId)
 	if err != nil {
 		return sendErrorResponseFromError(c, err)
 	}
@@ -146,13 +205,72 @@ func (h *handler) ViewApplication(c echo.Context, federationContextId models.Fed
 		return sendErrorResponseFromError(c, err)
 	}
 
-	return c.JSON(http.StatusOK, app.ViewApplication200JSONResponse)
+	return c.JSON(http.StatusOK, viewApplicationResponse{
+		ViewApplication200JSONResponse: app.ViewApplication200JSONResponse,
+		StatusInfo:                     app.DeploymentZones,
+	})
+}
+
+// Onboards an existing application to a new zone within partner OP.
+// (POST /{federationContextId}/application/onboarding/app/{appId}/additionalZones)
+func (h *handler) OnboardExistingAppNewZones(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.OnboardExistingAppNewZonesJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.metaStoreClient.AddApplicationZones(ctx, federationContextId, appId, *request); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, nil)
+}
+
+// Updates partner OP about changes in application compute resource requirements,
+// QOS Profile, associated descriptor, or change in associated components
+// (PATCH /{federationContextId}/application/onboarding/app/{appId})
+func (h *handler) UpdateApplication(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.UpdateApplicationJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if _, err := h.metaStoreClient.UpdateApplication(ctx, &metastore.UpdateApplication{
+		UpdateApplicationJSONBody: request,
+		FederationContextId:       federationContextId,
+		AppId:                     appId,
+	}); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	return c.JSON(http.StatusAccepted, nil)
+}
+
+// Forbid/allow application instantiation on a partner zone
+// (POST /{federationContextId}/application/onboarding/app/{appId}/zoneForbid)
+func (h *handler) LockUnlockApplicationZone(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.LockUnlockApplicationZoneJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := h.metaStoreClient.LockUnlockApplicationZones(ctx, federationContextId, appId, *request); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	return c.JSON(http.StatusOK, nil)
 }
 
 // Deboards an application from partner OP zones
 // (DELETE /{federationContextId}/application/onboarding/app/{appId}/zone/{zoneId})
 func (h *handler) DeboardApplication(c echo.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, zoneId models.ZoneIdentifier) error {
-	if err := h.metaStoreClient.RemoveApplication(h.getRequestContextFunc(c), federationContextId, appId); err != nil {
+	if err := h.metaStoreClient.RemoveApplicationZone(h.getRequestContextFunc(c), federationContextId, appId, zoneId); err != nil {
 		return sendErrorResponseFromError(c, err)
 	}
 
@@ -272,6 +390,44 @@ func (h *handler) GetFederationDetails(c echo.Context, federationContextId model
 		},
 		OfferedAvailabilityZones: fed.OfferedAvailabilityZones,
 	}
+	if h.serviceEndpoint != nil {
+		response.EdgeDiscoveryServiceEndPoint = *h.serviceEndpoint
+		response.LcmServiceEndPoint = *h.serviceEndpoint
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// API used by the Originating OP towards the partner OP, to update the parameters associated to the existing federation
+// (PATCH /{federationContextId}/partner)
+func (h *handler) UpdateFederation(c echo.Context, federationContextId models.FederationContextId) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.UpdateFederationJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	fed, err := h.metaStoreClient.UpdateFederation(ctx, &metastore.UpdateFederation{
+		UpdateFederationJSONBody: request,
+		FederationContextId:      federationContextId,
+	})
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	response := server.UpdateFederation200JSONResponse{
+		AllowedFixedNetworkIds: fed.OrigOPFixedNetworkCodes,
+		AllowedMobileNetworkIds: &models.MobileNetworkIds{
+			Mcc:  fed.OrigOPMobileNetworkCodes.Mcc,
+			Mncs: fed.OrigOPMobileNetworkCodes.Mncs,
+		},
+		OfferedAvailabilityZones: fed.OfferedAvailabilityZones,
+	}
+	if h.serviceEndpoint != nil {
+		response.EdgeDiscoveryServiceEndPoint = *h.serviceEndpoint
+		response.LcmServiceEndPoint = *h.serviceEndpoint
+	}
 
 	return c.JSON(http.StatusOK, response)
 }
@@ -340,11 +496,27 @@ func (h *handler) GetZoneData(c echo.Context, federationContextId models.Federat
 	return c.JSON(http.StatusOK, data)
 }
 
+// Asservate usage of a partner OP zone.
+// Originating OP informs partner OP that it will no longer access the specified zone.
+// (DELETE /{federationContextId}/zones/{zoneId})
+func (h *handler) ZoneUnsubscribe(c echo.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier) error {
+	ctx := h.getRequestContextFunc(c)
+
+	if err := h.metaStoreClient.RemoveAvailabilityZones(ctx, federationContextId, []string{zoneId}); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	return c.JSON(http.StatusOK, nil)
+}
+
 func getRequestContext(c echo.Context) context.Context {
 	return c.Request().Context()
 }
 
 func getRequestClientCredentials(c echo.Context) (metastore.ClientCredentials, error) {
+	if clientID, ok := getAuthenticatedClientID(c); ok {
+		return metastore.ClientCredentials{ClientID: clientID}, nil
+	}
+
 	headerErrorResponse := func(header string) (metastore.ClientCredentials, error) {
 		return metastore.ClientCredentials{}, fmt.Errorf("missing %s header", header)
 	}
@@ -355,3 +527,109 @@ func getRequestClientCredentials(c echo.Context) (metastore.ClientCredentials, e
 	}
 	return metastore.ClientCredentials{ClientID: clientID}, nil
 }
+
+// Reserves resources (compute, network and storage) on a partner OP zone.
+// ISVs registered with home OP reserves resources on a partner OP zone.
+// (POST /{federationContextId}/isv/resource/zone/{zoneId}/appProvider/{appProviderId})
+func (h *handler) CreateResourcePools(c echo.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.CreateResourcePoolsJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	pool, err := h.metaStoreClient.CreateResourcePool(ctx, &metastore.CreateResourcePool{
+		CreateResourcePoolsJSONBody: request,
+		FederationContextId:         federationContextId,
+		ZoneId:                      zoneId,
+		AppProviderId:               appProviderId,
+	})
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	h.notifyResourceReservation(ctx, pool)
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// Retrieves the resource pool reserved by an ISV
+// (GET /{federationContextId}/isv/resource/zone/{zoneId}/appProvider/{appProviderId})
+func (h *handler) ViewISVResPool(c echo.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId) error {
+	pools, err := h.metaStoreClient.ListResourcePools(h.getRequestContextFunc(c), federationContextId, zoneId, appProviderId)
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	res := make(server.ViewISVResPool200JSONResponse, len(pools))
+	for i, pool := range pools {
+		res[i].PoolName = pool.PoolName
+		res[i].ReservedPoolId = pool.PoolId
+		res[i].ReserveDuration = &pool.ReserveDuration
+		res[i].ReservationTime = &pool.ReservationTime
+		res[i].ReservedFlavours = make([]struct {
+			Count     int32            `json:"count"`
+			FlavourId models.FlavourId `json:"flavourId"`
+		}, len(pool.Flavours))
+		for j, flavour := range pool.Flavours {
+			res[i].ReservedFlavours[j].Count = flavour.NumFlavour
+			res[i].ReservedFlavours[j].FlavourId = flavour.FlavourId
+		}
+	}
+	return c.JSON(http.StatusOK, res)
+}
+
+// Deletes the resource pool reserved by an ISV
+// (DELETE /{federationContextId}/isv/resource/zone/{zoneId}/appProvider/{appProviderId}/pool/{poolId})
+func (h *handler) RemoveISVResPool(c echo.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, poolId models.PoolId) error {
+	if err := h.metaStoreClient.RemoveResourcePool(h.getRequestContextFunc(c), federationContextId, zoneId, appProviderId, poolId); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	return c.JSON(http.StatusOK, nil)
+}
+
+// Updates resources reserved for a pool by an ISV
+// (PATCH /{federationContextId}/isv/resource/zone/{zoneId}/appProvider/{appProviderId}/pool/{poolId})
+func (h *handler) UpdateISVResPool(c echo.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, poolId models.PoolId) error {
+	ctx := h.getRequestContextFunc(c)
+
+	request, err := bindRequest[models.UpdateISVResPoolJSONBody](c)
+	if err != nil {
+		return sendErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	pool, err := h.metaStoreClient.UpdateResourcePool(ctx, &metastore.UpdateResourcePool{
+		UpdateISVResPoolJSONBody: *request,
+		FederationContextId:      federationContextId,
+		ZoneId:                   zoneId,
+		AppProviderId:            appProviderId,
+		PoolId:                   poolId,
+	})
+	if err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+	h.notifyResourceReservation(ctx, pool)
+
+	return c.JSON(http.StatusOK, nil)
+}
+
+// Validates the authenticity of a roaming user from home OP
+// (GET /{federationContextId}/roaminguserauth/device/{deviceId}/token/{authToken})
+func (h *handler) AuthenticateDevice(c echo.Context, federationContextId models.FederationContextId, deviceId models.DeviceId, authToken models.AuthorizationToken) error {
+	ctx := h.getRequestContextFunc(c)
+
+	if h.deviceTokenVerifier == nil {
+		return sendErrorResponse(c, http.StatusServiceUnavailable, "device authentication not configured")
+	}
+	if _, err := h.metaStoreClient.GetFederation(ctx, federationContextId); err != nil {
+		return sendErrorResponseFromError(c, err)
+	}
+
+	if err := h.deviceTokenVerifier.VerifyDeviceToken(ctx, federationContextId, deviceId, authToken); err != nil {
+		if errors.Is(err, deviceauth.ErrInvalidToken) || errors.Is(err, deviceauth.ErrUnknownDevice) {
+			return sendErrorResponseFromError(c, err)
+		}
+		return sendErrorResponse(c, http.StatusServiceUnavailable, err.Error())
+	}
+	return c.JSON(http.StatusOK, nil)
+}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/icza/gog"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/server"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore/mock"
+)
+
+func Test_FederationDetails_serviceEndpoints(t *testing.T) {
+	fed := &metastore.Federation{
+		FederationRequestData: &models.FederationRequestData{
+			OrigOPMobileNetworkCodes: &models.MobileNetworkIds{Mcc: gog.Ptr("214"), Mncs: &[]string{"01"}},
+		},
+		FederationContextId: "fed",
+	}
+	endpoint, err := NewServiceEndpoint("ewbi.example.com:8443")
+	require.NoError(t, err)
+	h := &handler{
+		getRequestContextFunc: func(echo.Context) context.Context { return context.Background() },
+		metaStoreClient: &mock.FakeMetaStoreClient{
+			GetFederationFunc: func(string) (*metastore.Federation, error) { return fed, nil },
+			UpdateFederationFunc: func(*metastore.UpdateFederation) (*metastore.Federation, error) {
+				return fed, nil
+			},
+		},
+		serviceEndpoint: endpoint,
+	}
+	e := echo.New()
+	server.RegisterHandlers(e, h)
+
+	tests := []struct {
+		name   string
+		method string
+		body   string
+	}{
+		{"GetFederationDetails", http.MethodGet, ""},
+		{"UpdateFederation", http.MethodPatch, `{"objectType":"MOBILE_NETWORK_CODES","operationType":"ADD_CODES","modificationDate":"2024-01-01T00:00:00Z"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/fed/partner", strings.NewReader(tt.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+
+			var res struct {
+				EdgeDiscoveryServiceEndPoint json.RawMessage `json:"edgeDiscoveryServiceEndPoint"`
+				LcmServiceEndPoint           json.RawMessage `json:"lcmServiceEndPoint"`
+			}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.JSONEq(t, `{"fqdn":"ewbi.example.com","port":8443}`, string(res.EdgeDiscoveryServiceEndPoint))
+			require.JSONEq(t, `{"fqdn":"ewbi.example.com","port":8443}`, string(res.LcmServiceEndPoint))
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package jwks
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+const (
+	// minRefreshInterval limits how often the key set is downloaded again, when a
+	// token references an unknown key or when the previous download failed.
+	minRefreshInterval = 30 * time.Second
+	defaultTimeout     = 10 * time.Second
+	// maxKeySetSize is the largest key set accepted from the identity provider.
+	maxKeySetSize = 1 << 20
+)
+
+// Remote is a JSON Web Key Set served by an identity provider. The set is
+// downloaded again when it is older than the refresh interval or when a token
+// references a key that is not in it, so rotated keys are picked up.
+// A single download runs at a time, the concurrent requests wait for it.
+type Remote struct {
+	url             string
+	httpClient      *http.Client
+	refreshInterval time.Duration
+
+	mu          sync.Mutex
+	keys        *KeySet
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	err         error
+	fetching    chan struct{}
+}
+
+// NewRemote returns the key set served at url. If httpClient is nil, a client
+// with a 10 seconds timeout is used.
+func NewRemote(url string, httpClient *http.Client, refreshInterval time.Duration) *Remote {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &Remote{url: url, httpClient: httpClient, refreshInterval: refreshInterval}
+}
+
+// Keyfunc returns the key that verifies the token, see KeySet.Keyfunc.
+func (r *Remote) Keyfunc(token *jwt.Token) (interface{}, error) {
+	r.mu.Lock()
+	keys, stale := r.keys, r.keys == nil || time.Since(r.fetchedAt) > r.refreshInterval
+	r.mu.Unlock()
+
+	if stale {
+		refreshed, err := r.refresh()
+		if refreshed == nil {
+			return nil, err
+		}
+		keys = refreshed
+	}
+	key, err := keys.Keyfunc(token)
+	if err == nil {
+		return key, nil
+	}
+	// the key may have been rotated
+	refreshed, _ := r.refresh()
+	if refreshed == nil || refreshed == keys {
+		return nil, err
+	}
+	return refreshed.Keyfunc(token)
+}
+
+// refresh downloads the key set, unless it was attempted less than
+// minRefreshInterval ago, and returns the last downloaded one along with the
+// error of the last attempt.
+func (r *Remote) refresh() (*KeySet, error) {
+	r.mu.Lock()
+	if fetching := r.fetching; fetching != nil {
+		r.mu.Unlock()
+		<-fetching
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		return r.keys, r.err
+	}
+	if !r.attemptedAt.IsZero() && time.Since(r.attemptedAt) < minRefreshInterval {
+		defer r.mu.Unlock()
+		return r.keys, r.err
+	}
+	fetching := make(chan struct{})
+	r.fetching, r.attemptedAt = fetching, time.Now()
+	r.mu.Unlock()
+
+	keys, err := r.fetch()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err == nil {
+		r.keys, r.fetchedAt = keys, time.Now()
+	}
+	r.err, r.fetching = err, nil
+	close(fetching)
+	return r.keys, r.err
+}
+
+func (r *Remote) fetch() (*KeySet, error) {
+	resp, err := r.httpClient.Get(r.url)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to download key set '%s'", r.url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unable to download key set '%s': status %d", r.url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxKeySetSize+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read key set '%s'", r.url)
+	}
+	if len(data) > maxKeySetSize {
+		return nil, errors.Errorf("key set '%s' is larger than %d bytes", r.url, maxKeySetSize)
+	}
+	return Parse(data)
+}
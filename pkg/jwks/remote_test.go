@@ -0,0 +1,108 @@
+package jwks
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Remote(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	data, err := Marshal(&KeySet{Keys: []Key{{ID: "key", Algorithm: "RS256", Key: &key.PublicKey}}})
+	require.NoError(t, err)
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{})
+	token.Header["kid"] = "key"
+
+	var requests atomic.Int32
+	var unavailable atomic.Bool
+	release := make(chan struct{})
+	close(release)
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		mu.Lock()
+		wait := release
+		mu.Unlock()
+		<-wait
+		if unavailable.Load() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write(data)
+	}))
+	defer server.Close()
+
+	t.Run("Concurrent requests share the download", func(t *testing.T) {
+		requests.Store(0)
+		r := NewRemote(server.URL, nil, time.Hour)
+		mu.Lock()
+		release = make(chan struct{})
+		mu.Unlock()
+
+		keys := make(chan interface{}, 10)
+		for range cap(keys) {
+			go func() {
+				got, _ := r.Keyfunc(token)
+				keys <- got
+			}()
+		}
+		require.Eventually(t, func() bool { return requests.Load() == 1 }, time.Second, time.Millisecond)
+		close(release)
+		for range cap(keys) {
+			require.Equal(t, &key.PublicKey, <-keys)
+		}
+		require.Equal(t, int32(1), requests.Load())
+	})
+
+	t.Run("Failed downloads are not retried right away", func(t *testing.T) {
+		requests.Store(0)
+		unavailable.Store(true)
+		r := NewRemote(server.URL, nil, time.Hour)
+		for range 3 {
+			_, err := r.Keyfunc(token)
+			require.Error(t, err)
+		}
+		require.Equal(t, int32(1), requests.Load())
+
+		unavailable.Store(false)
+		r.attemptedAt = r.attemptedAt.Add(-minRefreshInterval)
+		_, err := r.Keyfunc(token)
+		require.NoError(t, err)
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("Stale keys are kept when the download fails", func(t *testing.T) {
+		requests.Store(0)
+		r := NewRemote(server.URL, nil, time.Minute)
+		_, err := r.Keyfunc(token)
+		require.NoError(t, err)
+
+		unavailable.Store(true)
+		defer unavailable.Store(false)
+		r.fetchedAt = r.fetchedAt.Add(-time.Hour)
+		r.attemptedAt = r.attemptedAt.Add(-time.Hour)
+		got, err := r.Keyfunc(token)
+		require.NoError(t, err)
+		require.Equal(t, &key.PublicKey, got)
+		require.Equal(t, int32(2), requests.Load())
+	})
+
+	t.Run("Oversized key sets are rejected", func(t *testing.T) {
+		large := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"keys":[],"padding":"`))
+			_, _ = w.Write(make([]byte, maxKeySetSize))
+		}))
+		defer large.Close()
+		_, err := NewRemote(large.URL, nil, time.Hour).Keyfunc(token)
+		require.ErrorContains(t, err, "larger than")
+	})
+}
@@ -0,0 +1,61 @@
+package metastore
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Annotations hold the state the api needs to keep on the custom resources
+// that has no counterpart in the operator specs.
+
+type annotationKey string
+
+const (
+	credentialsSecretAnnotation annotationKey = "credentials-secret"
+	deploymentZonesAnnotation   annotationKey = "deployment-zones"
+	forbiddenZonesAnnotation    annotationKey = "forbidden-zones"
+	modificationDateAnnotation  annotationKey = "modification-date"
+	partnerDetailsAnnotation    annotationKey = "partner-details"
+	zoneStatusAnnotation        annotationKey = "zone-status"
+)
+
+func opgAnnotation(a annotationKey) string {
+	return opgLabelKeyPrefix + "/" + string(a)
+}
+
+func getAnnotation(obj metav1.Object, a annotationKey) string {
+	return obj.GetAnnotations()[opgAnnotation(a)]
+}
+
+func setAnnotation(obj metav1.Object, a annotationKey, value string) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[opgAnnotation(a)] = value
+	obj.SetAnnotations(annotations)
+}
+
+// getAnnotationJSON decodes the JSON value of the annotation into v.
+// A missing annotation leaves v untouched.
+func getAnnotationJSON(obj metav1.Object, a annotationKey, v any) error {
+	value := getAnnotation(obj, a)
+	if value == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), v); err != nil {
+		return errors.Wrapf(ErrInternal, "invalid %s annotation: %s", a, err.Error())
+	}
+	return nil
+}
+
+func setAnnotationJSON(obj metav1.Object, a annotationKey, v any) error {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s annotation", a)
+	}
+	setAnnotation(obj, a, string(value))
+	return nil
+}
@@ -3,6 +3,7 @@ package metastore
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -16,6 +17,13 @@ import (
 type Application struct {
 	*camara.ViewApplication200JSONResponse
 	FederationContextId models.FederationContextId
+	DeploymentZones     []ApplicationZone
+}
+
+// ApplicationZone is the onboarding state of an application in one of its deployment zones.
+type ApplicationZone struct {
+	ZoneId            models.ZoneIdentifier       `json:"zoneId"`
+	OnboardStatusInfo opgv1beta1.ApplicationState `json:"onboardStatusInfo"`
 }
 
 type OnboardApplication struct {
@@ -23,6 +31,12 @@ type OnboardApplication struct {
 	FederationContextId models.FederationContextId
 }
 
+type UpdateApplication struct {
+	*models.UpdateApplicationJSONBody
+	FederationContextId models.FederationContextId
+	AppId               models.AppIdentifier
+}
+
 func (a *OnboardApplication) MarshalJSON() ([]byte, error) {
 	cp := *a.OnboardApplicationJSONBody
 	return json.Marshal(&cp)
@@ -47,6 +61,9 @@ func (a *OnboardApplication) k8sCustomResource(namespace string, opts ...Opt) (*
 			StatusLink:     a.AppStatusCallbackLink,
 		},
 	}
+	if err := setApplicationZones(obj, newApplicationZones(defaultIfNil(a.AppDeploymentZones))); err != nil {
+		return nil, err
+	}
 	for _, opt := range opts {
 		if err := opt(&obj.ObjectMeta); err != nil {
 			return nil, err
@@ -76,13 +93,17 @@ func (a *OnboardApplication) componentSpecs() []opgv1beta1.ComponentSpecRef {
 
 func (a *OnboardApplication) metaData() opgv1beta1.AppMetaData {
 	return opgv1beta1.AppMetaData{
-		AccessToken:     a.AppMetaData.AccessToken,
 		Name:            a.AppMetaData.AppName,
 		MobilitySupport: defaultIfNil(a.AppMetaData.MobilitySupport),
 		Version:         a.AppMetaData.Version,
 	}
 }
 
+// credentials are the application access token, kept in a Secret.
+func (a *OnboardApplication) credentials() map[string]string {
+	return map[string]string{secretAccessTokenKey: a.AppMetaData.AccessToken}
+}
+
 func (a *OnboardApplication) qosProfile() opgv1beta1.QoSProfile {
 	return opgv1beta1.QoSProfile{
 		Provisioning:       defaultIfNil(a.AppQoSProfile.AppProvisioning),
@@ -92,6 +113,88 @@ func (a *OnboardApplication) qosProfile() opgv1beta1.QoSProfile {
 	}
 }
 
+// updatek8sCustomResource applies the QoS profile changes and the resolved component specs to the application.
+func (u *UpdateApplication) updatek8sCustomResource(app *opgv1beta1.Application, componentSpecs []opgv1beta1.ComponentSpecRef) *opgv1beta1.Application {
+	if componentSpecs != nil {
+		app.Spec.ComponentSpecs = componentSpecs
+	}
+	if qos := u.AppUpdQoSProfile; qos != nil {
+		if qos.AppProvisioning != nil {
+			app.Spec.QoSProfile.Provisioning = *qos.AppProvisioning
+		}
+		if qos.LatencyConstraints != nil {
+			app.Spec.QoSProfile.LatencyConstraints = string(*qos.LatencyConstraints)
+		}
+		if qos.MultiUserClients != nil {
+			app.Spec.QoSProfile.MultiUserClients = string(*qos.MultiUserClients)
+		}
+		if qos.NoOfUsersPerAppInst != nil {
+			app.Spec.QoSProfile.UsersPerAppInst = int64(*qos.NoOfUsersPerAppInst)
+		}
+		if qos.MobilitySupport != nil {
+			app.Spec.MetaData.MobilitySupport = *qos.MobilitySupport
+		}
+	}
+	return app
+}
+
+func newApplicationZones(zones []models.ZoneIdentifier) []ApplicationZone {
+	out := make([]ApplicationZone, len(zones))
+	for i, zone := range zones {
+		out[i] = ApplicationZone{
+			ZoneId:            zone,
+			OnboardStatusInfo: opgv1beta1.ApplicationStatePending,
+		}
+	}
+	return out
+}
+
+func getApplicationZones(app *opgv1beta1.Application) ([]ApplicationZone, error) {
+	zones := []ApplicationZone{}
+	if err := getAnnotationJSON(app, deploymentZonesAnnotation, &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+func setApplicationZones(app *opgv1beta1.Application, zones []ApplicationZone) error {
+	return setAnnotationJSON(app, deploymentZonesAnnotation, zones)
+}
+
+// getForbiddenZones returns the zones where new instances of the application must not be created.
+func getForbiddenZones(app *opgv1beta1.Application) ([]string, error) {
+	zones := []string{}
+	if err := getAnnotationJSON(app, forbiddenZonesAnnotation, &zones); err != nil {
+		return nil, err
+	}
+	return zones, nil
+}
+
+func setForbiddenZones(app *opgv1beta1.Application, zones []string) error {
+	return setAnnotationJSON(app, forbiddenZonesAnnotation, zones)
+}
+
+func isForbiddenZone(app *opgv1beta1.Application, zoneID string) (bool, error) {
+	zones, err := getForbiddenZones(app)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(zones, zoneID), nil
+}
+
+// applicationZonesStatus reports the onboarding state of every deployment zone.
+// The operator onboards the application as a whole, so zones waiting for it follow the application state.
+func applicationZonesStatus(app *opgv1beta1.Application, zones []ApplicationZone) []ApplicationZone {
+	out := make([]ApplicationZone, len(zones))
+	for i, zone := range zones {
+		out[i] = zone
+		if zone.OnboardStatusInfo == opgv1beta1.ApplicationStatePending && app.Status.State != "" {
+			out[i].OnboardStatusInfo = app.Status.State
+		}
+	}
+	return out
+}
+
 func k8sCustomResourceNameFromApplicationID(federationContextID, appID string) string {
 	return fmt.Sprintf("%s-%s", applicationKind, uuidV5Fn(federationContextID+"/"+appID))
 }
@@ -110,7 +213,14 @@ type appComponentSpec struct {
 	ServiceNameNB *string `json:"serviceNameNB,omitempty"`
 }
 
-func applicationFromK8sCustomResource(app opgv1beta1.Application) (*Application, error) {
+// applicationFromK8sCustomResource returns the application onboarded in zones,
+// as returned by k8sClient.applicationZones.
+func applicationFromK8sCustomResource(app opgv1beta1.Application, zones []ApplicationZone) (*Application, error) {
+	deploymentZones := make([]models.ZoneIdentifier, len(zones))
+	for i, zone := range zones {
+		deploymentZones[i] = zone.ZoneId
+	}
+
 	componentSpec := make(models.AppComponentSpecs, len(app.Spec.ComponentSpecs))
 	for i, cs := range app.Spec.ComponentSpecs {
 		componentSpec[i] = appComponentSpec{
@@ -119,8 +229,10 @@ func applicationFromK8sCustomResource(app opgv1beta1.Application) (*Application,
 	}
 	return &Application{
 		ViewApplication200JSONResponse: &camara.ViewApplication200JSONResponse{
-			AppProviderId:     app.Spec.AppProviderId,
-			AppComponentSpecs: componentSpec,
+			AppId:              app.Labels[opgLabel(idLabel)],
+			AppProviderId:      app.Spec.AppProviderId,
+			AppComponentSpecs:  componentSpec,
+			AppDeploymentZones: deploymentZones,
 			AppMetaData: models.AppMetaData{
 				AccessToken:     app.Spec.MetaData.AccessToken,
 				AppName:         app.Spec.MetaData.Name,
@@ -135,6 +247,7 @@ func applicationFromK8sCustomResource(app opgv1beta1.Application) (*Application,
 			},
 		},
 		FederationContextId: app.Labels[opgLabel(federationContextIDLabel)],
+		DeploymentZones:     applicationZonesStatus(&app, zones),
 	}, nil
 }
 
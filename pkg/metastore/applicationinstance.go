@@ -2,7 +2,9 @@ package metastore
 
 import (
 	"fmt"
+	"sort"
 
+	"github.com/icza/gog"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
@@ -14,6 +16,10 @@ type ApplicationInstanceDetails struct {
 	*camara.GetAppInstanceDetails200JSONResponse
 }
 
+type ApplicationInstances struct {
+	camara.GetAllAppInstances200JSONResponse
+}
+
 type ApplicationInstance struct {
 	*models.InstallAppJSONBody
 	FederationContextId models.FederationContextId `json:"-"`
@@ -28,6 +34,8 @@ func (d *ApplicationInstance) k8sCustomResource(namespace string, opts ...Opt) (
 				opgLabel(federationContextIDLabel): d.FederationContextId,
 				opgLabel(idLabel):                  d.AppInstanceId,
 				opgLabel(federationRelation):       host,
+				opgLabel(appIDLabel):               d.AppId,
+				opgLabel(appProviderIDLabel):       d.AppProviderId,
 			},
 		},
 		Spec: opgv1beta1.ApplicationInstanceSpec{
@@ -64,6 +72,78 @@ func k8sCustomResourceNameFromApplicationInstance(federationContextID, appID str
 	return fmt.Sprintf("%s-%s", applicationInstancePrefix, uuidV5Fn(federationContextID+"/"+appID))
 }
 
-func applicationInstanceFromK8sCustomResource(appInstanceID string, appInstance opgv1beta1.ApplicationInstance) (*ApplicationInstanceDetails, error) {
-	return &ApplicationInstanceDetails{}, nil
+func applicationInstanceFromK8sCustomResource(appInstance opgv1beta1.ApplicationInstance) *ApplicationInstanceDetails {
+	state := models.InstanceStatePENDING
+	if appInstance.Status.State != "" {
+		state = models.InstanceState(appInstance.Status.State)
+	}
+	res := &ApplicationInstanceDetails{
+		GetAppInstanceDetails200JSONResponse: &camara.GetAppInstanceDetails200JSONResponse{
+			AppInstanceState: &state,
+		},
+	}
+	if len(appInstance.Status.AccessPointInfo) > 0 {
+		res.AccessPointInfo = accessPointInfoFromK8sCustomResource(appInstance.Status.AccessPointInfo)
+	}
+	return res
+}
+
+func accessPointInfoFromK8sCustomResource(info []opgv1beta1.AccessPointInfo) *models.AccessPointInfo {
+	res := make(models.AccessPointInfo, len(info))
+	for i, accessPoint := range info {
+		res[i].InterfaceId = accessPoint.InterfaceId
+		res[i].AccessPoints.Port = accessPoint.AccessPoints.Port
+		if accessPoint.AccessPoints.Fqdn != "" {
+			res[i].AccessPoints.Fqdn = gog.Ptr(accessPoint.AccessPoints.Fqdn)
+		}
+		if len(accessPoint.AccessPoints.Ipv4Addresses) > 0 {
+			res[i].AccessPoints.Ipv4Addresses = gog.Ptr(accessPoint.AccessPoints.Ipv4Addresses)
+		}
+		if len(accessPoint.AccessPoints.Ipv6Addresses) > 0 {
+			ipv6Addresses := make([]models.Ipv6Addr, len(accessPoint.AccessPoints.Ipv6Addresses))
+			for j, addr := range accessPoint.AccessPoints.Ipv6Addresses {
+				ipv6Addresses[j] = addr
+			}
+			res[i].AccessPoints.Ipv6Addresses = &ipv6Addresses
+		}
+	}
+	return &res
+}
+
+// appInstanceInfo matches the anonymous instance entry of camara.GetAllAppInstances200JSONResponse.
+type appInstanceInfo = struct {
+	// AppInstIdentifier Unique identifier generated by the partner OP to identify an instance of the application on a specific zone.
+	AppInstIdentifier models.InstanceIdentifier `json:"appInstIdentifier"`
+
+	// AppInstanceState Running status of the application instance.
+	AppInstanceState models.InstanceState `json:"appInstanceState"`
+}
+
+// applicationInstancesFromK8sCustomResources groups the application instances by zone.
+func applicationInstancesFromK8sCustomResources(appInstances []opgv1beta1.ApplicationInstance) *ApplicationInstances {
+	zones := map[string]int{}
+	res := camara.GetAllAppInstances200JSONResponse{}
+	for _, appInstance := range appInstances {
+		zoneID := appInstance.Spec.ZoneInfo.ZoneId
+		i, ok := zones[zoneID]
+		if !ok {
+			i = len(res)
+			zones[zoneID] = i
+			res = append(res, make(camara.GetAllAppInstances200JSONResponse, 1)...)
+			res[i].ZoneId = zoneID
+		}
+		state := models.InstanceStatePENDING
+		switch {
+		case !appInstance.DeletionTimestamp.IsZero():
+			state = models.InstanceStateTERMINATING
+		case appInstance.Status.State != "":
+			state = models.InstanceState(appInstance.Status.State)
+		}
+		res[i].AppInstanceInfo = append(res[i].AppInstanceInfo, appInstanceInfo{
+			AppInstIdentifier: appInstance.Labels[opgLabel(idLabel)],
+			AppInstanceState:  state,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ZoneId < res[j].ZoneId })
+	return &ApplicationInstances{GetAllAppInstances200JSONResponse: res}
 }
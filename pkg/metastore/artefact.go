@@ -146,6 +146,16 @@ func artefactFromK8sCustomResource(artefact opgv1beta1.Artefact) (*Artefact, err
 	}, nil
 }
 
+// hasComponent reports whether the artefact describes a component with the given name.
+func (a *Artefact) hasComponent(name string) bool {
+	for _, cs := range defaultIfNil(a.ComponentSpec) {
+		if cs.ComponentName == name {
+			return true
+		}
+	}
+	return false
+}
+
 func isValidArtefactStatus(status string) bool {
 	switch opgv1beta1.ArtefactState(status) {
 	case opgv1beta1.ArtefactStateReconciling, opgv1beta1.ArtefactStateReady, opgv1beta1.ArtefactStateError, opgv1beta1.ArtefactStateUnknown:
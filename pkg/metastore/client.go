@@ -15,7 +15,10 @@ var uuidV5Fn = uuid.V5
 type Client interface {
 	GetFederation(ctx context.Context, federationContextID string) (*Federation, error)
 	CreateFederation(ctx context.Context, fed *Federation) (*Federation, error)
+	UpdateFederation(ctx context.Context, update *UpdateFederation) (*Federation, error)
 	UpdateFederationStatus(ctx context.Context, federationCallbackID string, status models.Status) error
+	UpdatePartnerStatus(ctx context.Context, update *UpdatePartnerStatus) error
+	GetPartnerDetails(ctx context.Context, federationCallbackID string) (*PartnerDetails, error)
 	RemoveFederation(ctx context.Context, federationContextID string) error
 
 	GetFile(ctx context.Context, federationContextID, id string) (*File, error)
@@ -31,21 +34,40 @@ type Client interface {
 
 	GetApplication(ctx context.Context, federationContextID, id string) (*Application, error)
 	OnboardApplication(ctx context.Context, app *OnboardApplication) (*opgv1beta1.Application, error)
+	UpdateApplication(ctx context.Context, app *UpdateApplication) (*opgv1beta1.Application, error)
+	AddApplicationZones(ctx context.Context, federationContextID, id string, zoneIDs []string) error
+	RemoveApplicationZone(ctx context.Context, federationContextID, id, zoneID string) error
+	LockUnlockApplicationZones(ctx context.Context, federationContextID, id string, locks models.LockUnlockApplicationZoneJSONBody) error
 	UpdateApplicationStatus(ctx context.Context, federationCallbackID string, updates *models.AppStatusCallbackLinkJSONRequestBody) error
 	RemoveApplication(ctx context.Context, federationContextID, id string) error
 
 	AddApplicationInstance(ctx context.Context, dep *ApplicationInstance) (*opgv1beta1.ApplicationInstance, error)
 	GetApplicationInstance(ctx context.Context, federationContextID, id string) (*ApplicationInstance, error)
+	CreateResourcePool(ctx context.Context, pool *CreateResourcePool) (*ResourcePool, error)
+	ListResourcePools(ctx context.Context, federationContextID, zoneID, appProviderID string) ([]*ResourcePool, error)
+	UpdateResourcePool(ctx context.Context, update *UpdateResourcePool) (*ResourcePool, error)
+	RemoveResourcePool(ctx context.Context, federationContextID, zoneID, appProviderID, poolID string) error
+	CreateResourcePoolReservation(ctx context.Context, reservation *ResourcePoolReservation) error
+	GetResourcePoolReservation(ctx context.Context, federationCallbackID, poolID string) (*ResourcePoolReservation, error)
+	UpdateResourcePoolReservationGrant(ctx context.Context, federationCallbackID string, grant *models.ResourceReservationCallbackLinkJSONRequestBody) error
+	ListCandidateZones(ctx context.Context, federationContextID, appID, appProviderID string, location *models.GeoLocation) ([]CandidateZone, error)
+	ListApplicationInstances(ctx context.Context, federationContextID, appID, appProviderID string) (*ApplicationInstances, error)
 	UpdateApplicationInstanceStatus(ctx context.Context, federationCallbackID string, updates *models.AppInstCallbackLinkJSONRequestBody) error
 	RemoveApplicationInstance(ctx context.Context, federationContextID, id string) error
 
-	GetApplicationInstanceDetails(ctx context.Context, federationContextID, id string) (*ApplicationInstanceDetails, error)
+	GetApplicationInstanceDetails(ctx context.Context, federationContextID, appID, id, zoneID string) (*ApplicationInstanceDetails, error)
 
 	AddAvailabilityZones(ctx context.Context, federationContextId string, azs []string) error
+	RemoveAvailabilityZones(ctx context.Context, federationContextId string, azs []string) error
 	AddAvailabilityZone(ctx context.Context, az *PartnerAvailabilityZone) error
 	GetAvailabilityZone(ctx context.Context, federationContextID, id string) (*PartnerAvailabilityZone, error)
 	ListAvailabilityZones(ctx context.Context) ([]*PartnerAvailabilityZone, error)
 	RemoveAvailabilityZone(ctx context.Context, federationContextID, id string) error
+	UpdateZoneResources(ctx context.Context, federationCallbackID string, updates *models.AvailZoneNotifLinkJSONRequestBody) error
+	GetZoneResources(ctx context.Context, federationCallbackID, zoneID string) (*ZoneResources, error)
 
 	GetClientCredentials(ctx context.Context, ClientID string) (ClientCredentials, error)
+	GetFederationClientID(ctx context.Context, federationContextID string) (string, error)
+	GetPartnerCallbackCredentials(ctx context.Context, federationContextID string) (*models.CallbackCredentials, error)
+	GetGuestFederationClientID(ctx context.Context, federationCallbackID string) (string, error)
 }
@@ -7,6 +7,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
 	opgv1beta1 "github.com/neonephos-katalis/opg-ewbi-operator/api/v1beta1"
 )
 
@@ -30,3 +31,49 @@ func (c *k8sClient) GetClientCredentials(ctx context.Context, ClientID string) (
 		ClientID: res.Spec.GuestPartnerCredentials.ClientId,
 	}, nil
 }
+
+// GetFederationClientID returns the client id of the partner OP that owns the federation.
+func (c *k8sClient) GetFederationClientID(ctx context.Context, federationContextID string) (string, error) {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return "", err
+	}
+	return fed.Labels[opgLabel(clientIDLabel)], nil
+}
+
+// GetPartnerCallbackCredentials returns the credentials the partner OP gave to
+// authenticate the callbacks of the federation, with their secret. It returns
+// nil when the partner OP gave none.
+func (c *k8sClient) GetPartnerCallbackCredentials(ctx context.Context, federationContextID string) (*models.CallbackCredentials, error) {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return nil, err
+	}
+	credentials := fed.Spec.Partner.CallbackCredentials
+	if credentials.ClientId == "" {
+		return nil, nil
+	}
+	secrets, err := c.getCredentials(fed)
+	if err != nil {
+		return nil, err
+	}
+	return &models.CallbackCredentials{
+		ClientId:     credentials.ClientId,
+		ClientSecret: string(secrets[secretClientSecretKey]),
+		TokenUrl:     credentials.TokenUrl,
+	}, nil
+}
+
+// GetGuestFederationClientID returns the client id of the partner OP allowed to
+// notify the federation we created as guest. Unless labelled, it is the client id
+// of the callback credentials we gave to the partner.
+func (c *k8sClient) GetGuestFederationClientID(ctx context.Context, federationCallbackID string) (string, error) {
+	fed, err := c.getGuestFederation(federationCallbackID)
+	if err != nil {
+		return "", err
+	}
+	if clientID := fed.Labels[opgLabel(clientIDLabel)]; clientID != "" {
+		return clientID, nil
+	}
+	return fed.Spec.Partner.CallbackCredentials.ClientId, nil
+}
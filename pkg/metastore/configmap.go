@@ -0,0 +1,67 @@
+package metastore
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// Entities that have no custom resource in the operator are stored as JSON
+// documents in ConfigMaps, labelled with their kind and owned by their federation.
+
+const (
+	configMapKind    string = "configMap"
+	configMapDataKey string = "data"
+)
+
+func newK8sConfigMap(name, namespace string, lbls map[string]string, v any, opts ...Opt) (*corev1.ConfigMap, error) {
+	obj := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    lbls,
+		},
+	}
+	if err := setConfigMapJSON(obj, v); err != nil {
+		return nil, err
+	}
+	for _, opt := range opts {
+		if err := opt(&obj.ObjectMeta); err != nil {
+			return nil, err
+		}
+	}
+	return obj, nil
+}
+
+func getConfigMapJSON(obj *corev1.ConfigMap, v any) error {
+	if err := json.Unmarshal([]byte(obj.Data[configMapDataKey]), v); err != nil {
+		return errors.Wrapf(ErrInternal, "invalid %s '%s': %s", getObjectKind(obj), obj.Name, err.Error())
+	}
+	return nil
+}
+
+func setConfigMapJSON(obj *corev1.ConfigMap, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s", getObjectKind(obj))
+	}
+	obj.Data = map[string]string{configMapDataKey: string(data)}
+	return nil
+}
+
+// listConfigMaps returns the ConfigMaps matching the specified labels.
+func (c *k8sClient) listConfigMaps(searchLabels labels.Set) ([]corev1.ConfigMap, error) {
+	objectList, err := c.searchKubernetesObjects(&corev1.ConfigMapList{}, searchLabels)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := objectList.(*corev1.ConfigMapList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected list type %T: %w", objectList, ErrInternal)
+	}
+	return list.Items, nil
+}
@@ -10,6 +10,7 @@ import (
 
 var ErrAlreadyExists = errors.New("already exists")
 var ErrBadRequest = errors.New("bad request")
+var ErrConflict = errors.New("conflict")
 var ErrInternal = errors.New("internal error")
 var ErrNotFound = errors.New("not found")
 var ErrUnauthorized = errors.New("unauthorized")
@@ -22,6 +23,10 @@ func IsBadRequestError(err error) bool {
 	return errors.Is(err, ErrBadRequest)
 }
 
+func IsConflictError(err error) bool {
+	return errors.Is(err, ErrConflict)
+}
+
 func IsInternalError(err error) bool {
 	return errors.Is(err, ErrInternal)
 }
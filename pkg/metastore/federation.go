@@ -1,6 +1,9 @@
 package metastore
 
 import (
+	"time"
+
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
@@ -15,6 +18,11 @@ type Federation struct {
 	OfferedAvailabilityZones  *[]models.ZoneDetails
 }
 
+type UpdateFederation struct {
+	*models.UpdateFederationJSONBody
+	FederationContextId models.FederationContextId
+}
+
 func (f *Federation) updatek8sCustomResource(fed *opgv1beta1.Federation) *opgv1beta1.Federation {
 	var aaz []string
 	if f.AcceptedAvailabilityZones != nil {
@@ -43,6 +51,11 @@ func (f *Federation) updatek8sCustomResource(fed *opgv1beta1.Federation) *opgv1b
 	return fed
 }
 
+// credentials are the secret of the partner callback credentials, kept in a Secret.
+func (f *Federation) credentials() map[string]string {
+	return map[string]string{secretClientSecretKey: f.PartnerCallbackCredentials.ClientSecret}
+}
+
 func federationFromK8sCustomResource(fed *opgv1beta1.Federation) (*Federation, error) {
 	offeredZones := make([]models.ZoneDetails, len(fed.Spec.OfferedAvailabilityZones))
 	for i, z := range fed.Spec.OfferedAvailabilityZones {
@@ -73,6 +86,128 @@ func federationFromK8sCustomResource(fed *opgv1beta1.Federation) (*Federation, e
 	}, nil
 }
 
+// updatek8sCustomResource applies the network code changes to the origin OP of the federation.
+// Updates older than the last applied modification are rejected.
+func (u *UpdateFederation) updatek8sCustomResource(fed *opgv1beta1.Federation) (*opgv1beta1.Federation, error) {
+	if err := checkModificationDate(fed, u.ModificationDate); err != nil {
+		return nil, err
+	}
+
+	var err error
+	switch u.ObjectType {
+	case models.UpdateFederationJSONBodyObjectTypeMOBILENETWORKCODES:
+		err = u.updateMobileNetworkCodes(&fed.Spec.OriginOP.MobileNetworkCodes)
+	case models.UpdateFederationJSONBodyObjectTypeFIXEDNETWORKCODES:
+		fed.Spec.OriginOP.FixedNetworkCodes, err = u.updateFixedNetworkCodes(fed.Spec.OriginOP.FixedNetworkCodes)
+	default:
+		err = errors.Wrapf(ErrBadRequest, "unsupported objectType '%s'", u.ObjectType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	setAnnotation(fed, modificationDateAnnotation, u.ModificationDate.Format(time.RFC3339Nano))
+	return fed, nil
+}
+
+func (u *UpdateFederation) updateMobileNetworkCodes(codes *opgv1beta1.MobileNetworkCodes) error {
+	add, remove := u.AddMobileNetworkIds, u.RemoveMobileNetworkIds
+	switch u.OperationType {
+	case models.ADDCODES:
+		if add == nil {
+			return errors.Wrap(ErrBadRequest, "missing addMobileNetworkIds")
+		}
+	case models.REMOVECODES:
+		if remove == nil {
+			return errors.Wrap(ErrBadRequest, "missing removeMobileNetworkIds")
+		}
+	case models.UPDATECODES:
+		if add == nil || remove == nil {
+			return errors.Wrap(ErrBadRequest, "missing addMobileNetworkIds or removeMobileNetworkIds")
+		}
+	default:
+		return errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+	}
+
+	if remove != nil {
+		if remove.Mcc != nil && *remove.Mcc != codes.MCC {
+			return errors.Wrapf(ErrBadRequest, "mcc '%s' does not match the federation mcc '%s'", *remove.Mcc, codes.MCC)
+		}
+		mncs := defaultIfNil(remove.Mncs)
+		if missing := notIn(mncs, codes.MNC); len(missing) > 0 {
+			return errors.Wrapf(ErrBadRequest, "mncs %v are not part of the federation", missing)
+		}
+		codes.MNC = removeAll(codes.MNC, mncs)
+	}
+	if add != nil {
+		if add.Mcc != nil && *add.Mcc != codes.MCC {
+			// the mcc can only be replaced once no mnc of the previous one is left
+			if len(codes.MNC) > 0 {
+				return errors.Wrapf(ErrBadRequest, "mcc '%s' does not match the federation mcc '%s'", *add.Mcc, codes.MCC)
+			}
+			codes.MCC = *add.Mcc
+		}
+		codes.MNC = mergeUnique(codes.MNC, defaultIfNil(add.Mncs))
+	}
+	return nil
+}
+
+func (u *UpdateFederation) updateFixedNetworkCodes(codes []string) ([]string, error) {
+	add, remove := u.AddFixedNetworkIds, u.RemoveFixedNetworkIds
+	switch u.OperationType {
+	case models.ADDCODES:
+		if add == nil {
+			return nil, errors.Wrap(ErrBadRequest, "missing addFixedNetworkIds")
+		}
+	case models.REMOVECODES:
+		if remove == nil {
+			return nil, errors.Wrap(ErrBadRequest, "missing removeFixedNetworkIds")
+		}
+	case models.UPDATECODES:
+		if add == nil || remove == nil {
+			return nil, errors.Wrap(ErrBadRequest, "missing addFixedNetworkIds or removeFixedNetworkIds")
+		}
+	default:
+		return nil, errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+	}
+
+	if remove != nil {
+		if missing := notIn(*remove, codes); len(missing) > 0 {
+			return nil, errors.Wrapf(ErrBadRequest, "fixed network ids %v are not part of the federation", missing)
+		}
+		codes = removeAll(codes, *remove)
+	}
+	if add != nil {
+		codes = mergeUnique(codes, *add)
+	}
+	return codes, nil
+}
+
+// checkModificationDate rejects modifications older than the last one applied to the federation.
+func checkModificationDate(fed *opgv1beta1.Federation, modificationDate time.Time) error {
+	lastModification := fed.Spec.InitialDate.Time
+	if value := getAnnotation(fed, modificationDateAnnotation); value != "" {
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return errors.Wrapf(ErrInternal, "invalid %s annotation '%s'", modificationDateAnnotation, value)
+		}
+		lastModification = t
+	}
+	if !modificationDate.After(lastModification) {
+		return errors.Wrapf(ErrConflict, "modification date '%s' is not after the last federation modification '%s'",
+			modificationDate.Format(time.RFC3339), lastModification.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// validateAcceptedZones ensures the zones were accepted by the originating OP of the federation.
+func validateAcceptedZones(fed *opgv1beta1.Federation, zones []string) error {
+	if missing := notIn(zones, fed.Spec.AcceptedAvailabilityZones); len(missing) > 0 {
+		return errors.Wrapf(ErrBadRequest, "zones %v are not accepted availability zones of the federation", missing)
+	}
+	return nil
+}
+
 func isValidFederationStatus(status string) bool {
 	switch opgv1beta1.FederationState(status) {
 	case opgv1beta1.FederationStateFailed, opgv1beta1.FederationStateTemporaryFailure, opgv1beta1.FederationStateAvailable, opgv1beta1.FederationStateLocked, opgv1beta1.FederationStateNotAvailable:
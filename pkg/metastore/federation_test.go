@@ -0,0 +1,188 @@
+package metastore
+
+import (
+	"testing"
+	"time"
+
+	"github.com/icza/gog"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	opgv1beta1 "github.com/neonephos-katalis/opg-ewbi-operator/api/v1beta1"
+)
+
+func Test_UpdateFederation_updatek8sCustomResource(t *testing.T) {
+	initialDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newFederation := func() *opgv1beta1.Federation {
+		return &opgv1beta1.Federation{
+			Spec: opgv1beta1.FederationSpec{
+				InitialDate: metav1.Time{Time: initialDate},
+				OriginOP: opgv1beta1.Origin{
+					FixedNetworkCodes: []string{"f1", "f2"},
+					MobileNetworkCodes: opgv1beta1.MobileNetworkCodes{
+						MCC: "214",
+						MNC: []string{"01", "02"},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("Add mobile network codes", func(t *testing.T) {
+		update := &UpdateFederation{UpdateFederationJSONBody: &models.UpdateFederationJSONBody{
+			ModificationDate:    initialDate.Add(time.Hour),
+			ObjectType:          models.UpdateFederationJSONBodyObjectTypeMOBILENETWORKCODES,
+			OperationType:       models.ADDCODES,
+			AddMobileNetworkIds: &models.MobileNetworkIds{Mncs: &[]string{"02", "03"}},
+		}}
+		fed, err := update.updatek8sCustomResource(newFederation())
+		require.NoError(t, err)
+		require.Equal(t, []string{"01", "02", "03"}, fed.Spec.OriginOP.MobileNetworkCodes.MNC)
+	})
+
+	t.Run("Update fixed network codes", func(t *testing.T) {
+		update := &UpdateFederation{UpdateFederationJSONBody: &models.UpdateFederationJSONBody{
+			ModificationDate:      initialDate.Add(time.Hour),
+			ObjectType:            models.UpdateFederationJSONBodyObjectTypeFIXEDNETWORKCODES,
+			OperationType:         models.UPDATECODES,
+			AddFixedNetworkIds:    &[]string{"f3"},
+			RemoveFixedNetworkIds: &[]string{"f1"},
+		}}
+		fed, err := update.updatek8sCustomResource(newFederation())
+		require.NoError(t, err)
+		require.Equal(t, []string{"f2", "f3"}, fed.Spec.OriginOP.FixedNetworkCodes)
+	})
+
+	t.Run("Remove unknown mobile network code", func(t *testing.T) {
+		update := &UpdateFederation{UpdateFederationJSONBody: &models.UpdateFederationJSONBody{
+			ModificationDate:       initialDate.Add(time.Hour),
+			ObjectType:             models.UpdateFederationJSONBodyObjectTypeMOBILENETWORKCODES,
+			OperationType:          models.REMOVECODES,
+			RemoveMobileNetworkIds: &models.MobileNetworkIds{Mcc: gog.Ptr("214"), Mncs: &[]string{"09"}},
+		}}
+		_, err := update.updatek8sCustomResource(newFederation())
+		require.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("Reject stale update", func(t *testing.T) {
+		fed := newFederation()
+		update := &UpdateFederation{UpdateFederationJSONBody: &models.UpdateFederationJSONBody{
+			ModificationDate:   initialDate.Add(2 * time.Hour),
+			ObjectType:         models.UpdateFederationJSONBodyObjectTypeFIXEDNETWORKCODES,
+			OperationType:      models.ADDCODES,
+			AddFixedNetworkIds: &[]string{"f3"},
+		}}
+		fed, err := update.updatek8sCustomResource(fed)
+		require.NoError(t, err)
+
+		update.ModificationDate = initialDate.Add(time.Hour)
+		_, err = update.updatek8sCustomResource(fed)
+		require.ErrorIs(t, err, ErrConflict)
+	})
+}
+
+func Test_UpdatePartnerStatus_updatek8sCustomResource(t *testing.T) {
+	initialDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	newFederation := func() *opgv1beta1.Federation {
+		return &opgv1beta1.Federation{
+			Spec: opgv1beta1.FederationSpec{
+				InitialDate: metav1.Time{Time: initialDate},
+			},
+			Status: opgv1beta1.FederationStatus{
+				OfferedAvailabilityZones: []opgv1beta1.ZoneDetails{
+					{ZoneId: "z1", Geolocation: "40.4168,-3.7038"},
+					{ZoneId: "z2", Geolocation: "41.3874,2.1686"},
+				},
+			},
+		}
+	}
+	zoneIDs := func(fed *opgv1beta1.Federation) []string {
+		ids := []string{}
+		for _, z := range fed.Status.OfferedAvailabilityZones {
+			ids = append(ids, z.ZoneId)
+		}
+		return ids
+	}
+
+	t.Run("Add zones", func(t *testing.T) {
+		fed := newFederation()
+		update := &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: initialDate.Add(time.Hour),
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeADD,
+			AddZones:         &[]models.ZoneDetails{{ZoneId: "z3"}, {ZoneId: "z1", GeographyDetails: "urban"}},
+		}}
+		require.NoError(t, update.updatek8sCustomResource(fed))
+		require.Equal(t, []string{"z1", "z2", "z3"}, zoneIDs(fed))
+		require.Equal(t, "urban", fed.Status.OfferedAvailabilityZones[0].GeographyDetails)
+	})
+
+	t.Run("Remove zones clears their status", func(t *testing.T) {
+		fed := newFederation()
+		update := &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: initialDate.Add(time.Hour),
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeSTATUS,
+			ZoneStatus: &[]struct {
+				Status models.Status         `json:"status"`
+				ZoneId models.ZoneIdentifier `json:"zoneId"`
+			}{{Status: "LOCKED", ZoneId: "z1"}, {Status: "AVAILABLE", ZoneId: "z2"}},
+		}}
+		require.NoError(t, update.updatek8sCustomResource(fed))
+
+		update = &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: initialDate.Add(2 * time.Hour),
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeREMOVE,
+			RemoveZones:      &[]string{"z1"},
+		}}
+		require.NoError(t, update.updatek8sCustomResource(fed))
+		require.Equal(t, []string{"z2"}, zoneIDs(fed))
+
+		statuses, err := getZoneStatuses(fed)
+		require.NoError(t, err)
+		require.Equal(t, map[string]models.Status{"z2": "AVAILABLE"}, statuses)
+	})
+
+	t.Run("Update partner endpoints and network codes", func(t *testing.T) {
+		fed := newFederation()
+		update := &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: initialDate.Add(time.Hour),
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeLCMSERVICE,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeUPDATE,
+			LcmSvcEndPoint:   &models.ServiceEndpoint{Fqdn: gog.Ptr("lcm.partner.example"), Port: 443},
+		}}
+		require.NoError(t, update.updatek8sCustomResource(fed))
+
+		update = &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate:    initialDate.Add(2 * time.Hour),
+			ObjectType:          models.PartnerStatusLinkJSONBodyObjectTypeMOBILENETWORKCODES,
+			OperationType:       models.PartnerStatusLinkJSONBodyOperationTypeADD,
+			AddMobileNetworkIds: &models.MobileNetworkIds{Mcc: gog.Ptr("214"), Mncs: &[]string{"01", "07"}},
+		}}
+		require.NoError(t, update.updatek8sCustomResource(fed))
+
+		details, err := getPartnerDetails(fed)
+		require.NoError(t, err)
+		require.Equal(t, "lcm.partner.example", *details.LcmSvcEndPoint.Fqdn)
+		require.Equal(t, opgv1beta1.MobileNetworkCodes{MCC: "214", MNC: []string{"01", "07"}}, details.MobileNetworkCodes)
+
+		update.OperationType = models.PartnerStatusLinkJSONBodyOperationTypeUPDATE
+		update.ModificationDate = initialDate.Add(3 * time.Hour)
+		require.ErrorIs(t, update.updatek8sCustomResource(fed), ErrBadRequest)
+	})
+
+	t.Run("Reject status of unknown zone", func(t *testing.T) {
+		update := &UpdatePartnerStatus{PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: initialDate.Add(time.Hour),
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeSTATUS,
+			ZoneStatus: &[]struct {
+				Status models.Status         `json:"status"`
+				ZoneId models.ZoneIdentifier `json:"zoneId"`
+			}{{Status: "AVAILABLE", ZoneId: "z9"}},
+		}}
+		require.ErrorIs(t, update.updatek8sCustomResource(newFederation()), ErrBadRequest)
+	})
+}
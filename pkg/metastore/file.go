@@ -22,10 +22,9 @@ func fileFromK8sCustomResource(fileID string, file opgv1beta1.File) (*File, erro
 			AppProviderId: file.Spec.AppProviderId,
 			FileId:        fileID,
 			FileName:      file.Spec.FileName,
+			// the repository password and token are not sent back
 			FileRepoLocation: &models.ObjectRepoLocation{
-				Password: &file.Spec.Repo.Password,
 				RepoURL:  &file.Spec.Repo.URL,
-				Token:    &file.Spec.Repo.Token,
 				UserName: &file.Spec.Repo.UserName,
 			},
 			FileType:        models.VirtImageType(file.Spec.FileType),
@@ -73,8 +72,6 @@ func (m *UploadFile) k8sCustomResource(namespace string, opts ...Opt) (*opgv1bet
 			Repo: opgv1beta1.Repo{
 				Type:     defaultIfNil((*string)(m.RepoType)),
 				URL:      defaultIfNil(m.FileRepoLocation.RepoURL),
-				Password: defaultIfNil(m.FileRepoLocation.Password),
-				Token:    defaultIfNil(m.FileRepoLocation.Token),
 				UserName: defaultIfNil(m.FileRepoLocation.UserName),
 			},
 			Image: opgv1beta1.Image{
@@ -97,6 +94,17 @@ func (m *UploadFile) k8sCustomResource(namespace string, opts ...Opt) (*opgv1bet
 	return obj, nil
 }
 
+// credentials are the repository credentials, kept in a Secret.
+func (m *UploadFile) credentials() map[string]string {
+	if m.FileRepoLocation == nil {
+		return nil
+	}
+	return map[string]string{
+		secretPasswordKey: defaultIfNil(m.FileRepoLocation.Password),
+		secretTokenKey:    defaultIfNil(m.FileRepoLocation.Token),
+	}
+}
+
 func k8sCustomResourceNameFromFileID(federationContextID, fileID string) string {
 	return fmt.Sprintf("%s-%s", fileKind, uuidV5Fn(federationContextID+"/"+fileID))
 }
@@ -0,0 +1,53 @@
+package metastore
+
+import (
+	"testing"
+
+	"github.com/icza/gog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func Test_UploadFile_credentials(t *testing.T) {
+	upload := func(location *models.ObjectRepoLocation) *UploadFile {
+		return &UploadFile{
+			UploadFileMultipartBody: &models.UploadFileMultipartBody{
+				FileId:           "file",
+				FileRepoLocation: location,
+			},
+			FederationContextId: "fed",
+		}
+	}
+
+	t.Run("Credentials kept out of the custom resource", func(t *testing.T) {
+		file := upload(&models.ObjectRepoLocation{
+			RepoURL:  gog.Ptr("https://repo.example"),
+			UserName: gog.Ptr("user"),
+			Password: gog.Ptr("password"),
+			Token:    gog.Ptr("token"),
+		})
+		obj, err := file.k8sCustomResource("ns")
+		require.NoError(t, err)
+		require.Empty(t, obj.Spec.Repo.Password)
+		require.Empty(t, obj.Spec.Repo.Token)
+
+		setCredentialsSecretRef(obj, file.credentials())
+		require.Equal(t, obj.Name+"-credentials", getAnnotation(obj, credentialsSecretAnnotation))
+
+		view, err := fileFromK8sCustomResource("file", *obj)
+		require.NoError(t, err)
+		require.Nil(t, view.FileRepoLocation.Password)
+		require.Nil(t, view.FileRepoLocation.Token)
+		require.Equal(t, "user", *view.FileRepoLocation.UserName)
+	})
+
+	t.Run("No secret without credentials", func(t *testing.T) {
+		file := upload(&models.ObjectRepoLocation{RepoURL: gog.Ptr("https://repo.example")})
+		obj, err := file.k8sCustomResource("ns")
+		require.NoError(t, err)
+
+		setCredentialsSecretRef(obj, file.credentials())
+		require.Empty(t, getAnnotation(obj, credentialsSecretAnnotation))
+	})
+}
@@ -0,0 +1,60 @@
+package metastore
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const earthRadiusKm = 6371.0
+
+type geoPoint struct {
+	lat, long float64
+}
+
+// parseGeoLocation parses a "latitude,longitude" GeoLocation.
+func parseGeoLocation(location string) (geoPoint, error) {
+	lat, long, found := strings.Cut(location, ",")
+	if !found {
+		return geoPoint{}, errors.Wrapf(ErrBadRequest, "invalid geolocation '%s'", location)
+	}
+	p := geoPoint{}
+	var err error
+	if p.lat, err = strconv.ParseFloat(strings.TrimSpace(lat), 64); err != nil || math.Abs(p.lat) > 90 {
+		return geoPoint{}, errors.Wrapf(ErrBadRequest, "invalid latitude in geolocation '%s'", location)
+	}
+	if p.long, err = strconv.ParseFloat(strings.TrimSpace(long), 64); err != nil || math.Abs(p.long) > 180 {
+		return geoPoint{}, errors.Wrapf(ErrBadRequest, "invalid longitude in geolocation '%s'", location)
+	}
+	return p, nil
+}
+
+// distanceKm returns the great-circle distance between two points using the haversine formula.
+func distanceKm(a, b geoPoint) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(b.lat - a.lat)
+	dLong := toRad(b.long - a.long)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.lat))*math.Cos(toRad(b.lat))*math.Sin(dLong/2)*math.Sin(dLong/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// sortZonesByDistance orders the zones from the closest to the farthest to the origin.
+// Zones without a valid geolocation are kept at the end in their original order.
+func sortZonesByDistance(zones []CandidateZone, origin geoPoint) {
+	distances := make(map[string]float64, len(zones))
+	for _, zone := range zones {
+		p, err := parseGeoLocation(zone.Geolocation)
+		if err != nil {
+			distances[zone.ZoneId] = math.Inf(1)
+			continue
+		}
+		distances[zone.ZoneId] = distanceKm(origin, p)
+	}
+	sort.SliceStable(zones, func(i, j int) bool {
+		return distances[zones[i].ZoneId] < distances[zones[j].ZoneId]
+	})
+}
@@ -0,0 +1,33 @@
+package metastore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_sortZonesByDistance(t *testing.T) {
+	zones := []CandidateZone{
+		{ZoneId: "unknown"},
+		{ZoneId: "madrid", Geolocation: "40.4168,-3.7038"},
+		{ZoneId: "barcelona", Geolocation: "41.3874, 2.1686"},
+		{ZoneId: "paris", Geolocation: "48.8566,2.3522"},
+	}
+	origin, err := parseGeoLocation("41.6488,-0.8891") // Zaragoza
+	require.NoError(t, err)
+
+	sortZonesByDistance(zones, origin)
+
+	ids := make([]string, len(zones))
+	for i, zone := range zones {
+		ids[i] = zone.ZoneId
+	}
+	require.Equal(t, []string{"barcelona", "madrid", "paris", "unknown"}, ids)
+}
+
+func Test_parseGeoLocation(t *testing.T) {
+	for _, location := range []string{"", "40.4", "95.0,1.0", "40.4,abc"} {
+		_, err := parseGeoLocation(location)
+		require.ErrorIs(t, err, ErrBadRequest, location)
+	}
+}
@@ -2,8 +2,12 @@ package metastore
 
 import (
 	"context"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -24,10 +28,19 @@ func NewK8sClient(c k8scli.Client, namespace string) *k8sClient {
 }
 
 func (c *k8sClient) AddApplicationInstance(ctx context.Context, dep *ApplicationInstance) (*opgv1beta1.ApplicationInstance, error) {
-	if _, err := c.GetApplication(ctx, dep.FederationContextId, dep.AppId); err != nil {
+	app, err := c.getApplication(dep.FederationContextId, dep.AppId)
+	if err != nil {
 		if IsNotFoundError(err) {
 			return nil, errors.Wrap(ErrBadRequest, err.Error())
 		}
+		return nil, err
+	}
+	forbidden, err := isForbiddenZone(app, dep.ZoneInfo.ZoneId)
+	if err != nil {
+		return nil, err
+	}
+	if forbidden {
+		return nil, errors.Wrapf(ErrConflict, "instantiation of application '%s' is forbidden in zone '%s'", dep.AppId, dep.ZoneInfo.ZoneId)
 	}
 	opt, err := c.buildOwnerReferenceOption(dep.FederationContextId)
 	if err != nil {
@@ -44,6 +57,218 @@ func (c *k8sClient) AddApplicationInstance(ctx context.Context, dep *Application
 	return obj, nil
 }
 
+func (c *k8sClient) ListApplicationInstances(ctx context.Context, federationContextID, appID, appProviderID string) (*ApplicationInstances, error) {
+	appInstances, err := c.listApplicationInstances(labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(federationRelation):       host,
+		opgLabel(appIDLabel):               appID,
+		opgLabel(appProviderIDLabel):       appProviderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applicationInstancesFromK8sCustomResources(appInstances), nil
+}
+
+func (c *k8sClient) CreateResourcePool(ctx context.Context, input *CreateResourcePool) (*ResourcePool, error) {
+	fed, err := c.getFederation(input.FederationContextId)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateAcceptedZones(fed, []string{input.ZoneId}); err != nil {
+		return nil, err
+	}
+	pool, err := input.resourcePool()
+	if err != nil {
+		return nil, err
+	}
+	obj, err := pool.k8sCustomResource(c.getNamespace(), WithOwnerReference(fed, c.getScheme()))
+	if err != nil {
+		return nil, err
+	}
+	if err := c.createK8sObject(obj); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (c *k8sClient) getResourcePool(federationContextID, zoneID, appProviderID, poolID string) (*corev1.ConfigMap, error) {
+	obj, err := c.searchKubernetesObject(&corev1.ConfigMapList{}, labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(idLabel):                  poolID,
+		opgLabel(federationRelation):       host,
+		opgLabel(kindLabel):                resourcePoolKind,
+		opgLabel(zoneIDLabel):              zoneID,
+		opgLabel(appProviderIDLabel):       appProviderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return nil, missMatchErr("resource pool", poolID, federationContextID, &corev1.ConfigMap{}, obj)
+	}
+	return cm, nil
+}
+
+func (c *k8sClient) ListResourcePools(ctx context.Context, federationContextID, zoneID, appProviderID string) ([]*ResourcePool, error) {
+	objs, err := c.listConfigMaps(labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(federationRelation):       host,
+		opgLabel(kindLabel):                resourcePoolKind,
+		opgLabel(zoneIDLabel):              zoneID,
+		opgLabel(appProviderIDLabel):       appProviderID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pools := make([]*ResourcePool, 0, len(objs))
+	for i := range objs {
+		pool, err := resourcePoolFromK8sCustomResource(&objs[i])
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
+func (c *k8sClient) UpdateResourcePool(ctx context.Context, update *UpdateResourcePool) (*ResourcePool, error) {
+	obj, err := c.getResourcePool(update.FederationContextId, update.ZoneId, update.AppProviderId, update.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := resourcePoolFromK8sCustomResource(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := update.apply(pool); err != nil {
+		return nil, err
+	}
+	if err := setConfigMapJSON(obj, pool); err != nil {
+		return nil, err
+	}
+	if err := c.updateK8sObject(obj); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (c *k8sClient) RemoveResourcePool(ctx context.Context, federationContextID, zoneID, appProviderID, poolID string) error {
+	obj, err := c.getResourcePool(federationContextID, zoneID, appProviderID, poolID)
+	if err != nil {
+		return err
+	}
+	appInstances, err := c.listApplicationInstances(labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(federationRelation):       host,
+		opgLabel(appProviderIDLabel):       appProviderID,
+	})
+	if err != nil {
+		return err
+	}
+	for _, appInstance := range appInstances {
+		if appInstance.Spec.ZoneInfo.ZoneId == zoneID && appInstance.Spec.ZoneInfo.ResPool == poolID && appInstance.DeletionTimestamp.IsZero() {
+			return errors.Wrapf(ErrConflict, "resource pool '%s' is used by running application instances", poolID)
+		}
+	}
+	if err := c.kubernetes.Delete(context.TODO(), obj, &k8scli.DeleteOptions{}); err != nil {
+		return errors.Wrapf(err, "unable to remove resource pool")
+	}
+	return nil
+}
+
+// CreateResourcePoolReservation records the flavours requested to a partner
+// so that the granted flavours notified later can be compared against them.
+// A grant the partner notified before the request was recorded is kept.
+// partnerclient.GuestReservation records the reservations the partner accepted.
+func (c *k8sClient) CreateResourcePoolReservation(ctx context.Context, reservation *ResourcePoolReservation) error {
+	obj, err := c.getResourcePoolReservation(reservation.FederationCallbackId, reservation.PoolId)
+	if IsNotFoundError(err) {
+		reservation.request(reservation.RequestedFlavours)
+		return c.createResourcePoolReservation(reservation)
+	}
+	if err != nil {
+		return err
+	}
+	stored, err := resourcePoolReservationFromK8sCustomResource(obj)
+	if err != nil {
+		return err
+	}
+	if stored.RequestTime != nil {
+		return errors.Wrapf(ErrAlreadyExists, "reservation of pool '%s'", reservation.PoolId)
+	}
+	stored.request(reservation.RequestedFlavours)
+	if err := setConfigMapJSON(obj, stored); err != nil {
+		return err
+	}
+	return c.updateK8sObject(obj)
+}
+
+func (c *k8sClient) createResourcePoolReservation(reservation *ResourcePoolReservation) error {
+	fed, err := c.getGuestFederation(reservation.FederationCallbackId)
+	if err != nil {
+		return err
+	}
+	obj, err := reservation.k8sCustomResource(c.getNamespace(), WithOwnerReference(fed, c.getScheme()))
+	if err != nil {
+		return err
+	}
+	return c.createK8sObject(obj)
+}
+
+func (c *k8sClient) getResourcePoolReservation(federationCallbackID, poolID string) (*corev1.ConfigMap, error) {
+	obj, err := c.searchKubernetesObject(&corev1.ConfigMapList{}, labels.Set{
+		opgLabel(federationCallbackIDLabel): federationCallbackID,
+		opgLabel(idLabel):                   poolID,
+		opgLabel(federationRelation):        guest,
+		opgLabel(kindLabel):                 resourcePoolReservationKind,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return nil, missMatchErr("resource pool reservation", poolID, federationCallbackID, &corev1.ConfigMap{}, obj)
+	}
+	return cm, nil
+}
+
+func (c *k8sClient) GetResourcePoolReservation(ctx context.Context, federationCallbackID, poolID string) (*ResourcePoolReservation, error) {
+	obj, err := c.getResourcePoolReservation(federationCallbackID, poolID)
+	if err != nil {
+		return nil, err
+	}
+	return resourcePoolReservationFromK8sCustomResource(obj)
+}
+
+// UpdateResourcePoolReservationGrant stores the flavours granted by the partner.
+// Grants for pools with no recorded request are stored as well, their state is
+// UNREQUESTED until the request is recorded.
+func (c *k8sClient) UpdateResourcePoolReservationGrant(ctx context.Context, federationCallbackID string, grant *models.ResourceReservationCallbackLinkJSONRequestBody) error {
+	obj, err := c.getResourcePoolReservation(federationCallbackID, grant.PoolId)
+	if IsNotFoundError(err) {
+		reservation := &ResourcePoolReservation{FederationCallbackId: federationCallbackID, PoolId: grant.PoolId}
+		reservation.grant(grant)
+		return c.createResourcePoolReservation(reservation)
+	}
+	if err != nil {
+		return err
+	}
+	reservation, err := resourcePoolReservationFromK8sCustomResource(obj)
+	if err != nil {
+		return err
+	}
+	if reservation.ZoneId != "" && reservation.ZoneId != grant.ZoneId {
+		return errors.Wrapf(ErrBadRequest, "pool '%s' was requested in zone '%s'", grant.PoolId, reservation.ZoneId)
+	}
+	reservation.grant(grant)
+	if err := setConfigMapJSON(obj, reservation); err != nil {
+		return err
+	}
+	return c.updateK8sObject(obj)
+}
+
 func (c *k8sClient) getFederation(federationContextID string) (*opgv1beta1.Federation, error) {
 	obj, err := c.getKubernetesObject(federationContextID, &opgv1beta1.FederationList{}, federationContextID)
 	if err != nil {
@@ -65,6 +290,40 @@ func (c *k8sClient) AddAvailabilityZones(ctx context.Context, federationContextI
 	return c.updateK8sObject(obj)
 }
 
+func (c *k8sClient) RemoveAvailabilityZones(ctx context.Context, federationContextID string, azs []string) error {
+	obj, err := c.getFederation(federationContextID)
+	if err != nil {
+		return err
+	}
+	accepted := make(map[string]struct{}, len(obj.Spec.AcceptedAvailabilityZones))
+	for _, az := range obj.Spec.AcceptedAvailabilityZones {
+		accepted[az] = struct{}{}
+	}
+	for _, az := range azs {
+		if _, ok := accepted[az]; !ok {
+			return errors.Wrapf(ErrNotFound, "accepted availability zone '%s'", az)
+		}
+	}
+
+	appInstances, err := c.listApplicationInstances(labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(federationRelation):       host,
+	})
+	if err != nil {
+		return err
+	}
+	for _, az := range azs {
+		for _, appInstance := range appInstances {
+			if appInstance.Spec.ZoneInfo.ZoneId == az && appInstance.DeletionTimestamp.IsZero() {
+				return errors.Wrapf(ErrConflict, "availability zone '%s' has running application instances", az)
+			}
+		}
+	}
+
+	obj.Spec.AcceptedAvailabilityZones = removeAll(obj.Spec.AcceptedAvailabilityZones, azs)
+	return c.updateK8sObject(obj)
+}
+
 func (c *k8sClient) CreateFederation(ctx context.Context, input *Federation) (*Federation, error) {
 	obj, err := c.searchKubernetesObject(&opgv1beta1.FederationList{}, labels.Set{
 		opgLabel(clientIDLabel):      input.ClientCredentials.ClientID,
@@ -82,7 +341,13 @@ func (c *k8sClient) CreateFederation(ctx context.Context, input *Federation) (*F
 	}
 
 	cr := input.updatek8sCustomResource(fed)
+	setCredentialsSecretRef(cr, input.credentials())
 
+	// the credentials are saved first, the federation is left unset when they
+	// cannot be, so that the partner can retry the creation
+	if err := c.saveCredentials(cr, input.credentials()); err != nil {
+		return nil, err
+	}
 	if err := c.updateK8sObject(cr); err != nil {
 		return nil, err
 	}
@@ -94,16 +359,53 @@ func (c *k8sClient) CreateFederation(ctx context.Context, input *Federation) (*F
 	return res, nil
 }
 
-func (c *k8sClient) GetApplication(ctx context.Context, federationContextID, id string) (*Application, error) {
-	app, err := c.getKubernetesObject(id, &opgv1beta1.ApplicationList{}, federationContextID)
+func (c *k8sClient) getApplication(federationContextID, id string) (*opgv1beta1.Application, error) {
+	obj, err := c.getKubernetesObject(id, &opgv1beta1.ApplicationList{}, federationContextID)
 	if err != nil {
 		return nil, err
 	}
-	res, ok := app.(*opgv1beta1.Application)
+	app, ok := obj.(*opgv1beta1.Application)
 	if !ok {
-		return nil, missMatchErr("application", id, federationContextID, &opgv1beta1.Application{}, app)
+		return nil, missMatchErr("application", id, federationContextID, &opgv1beta1.Application{}, obj)
+	}
+	return app, nil
+}
+
+// applicationZones returns the zones of the application. Applications onboarded
+// before their zones were recorded are taken as onboarded in the zones accepted
+// in the federation, with the state of the application.
+func (c *k8sClient) applicationZones(fed *opgv1beta1.Federation, app *opgv1beta1.Application) ([]ApplicationZone, error) {
+	if getAnnotation(app, deploymentZonesAnnotation) == "" {
+		return newApplicationZones(fed.Spec.AcceptedAvailabilityZones), nil
 	}
-	return applicationFromK8sCustomResource(*res)
+	return getApplicationZones(app)
+}
+
+func (c *k8sClient) GetApplication(ctx context.Context, federationContextID, id string) (*Application, error) {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.getApplication(federationContextID, id)
+	if err != nil {
+		return nil, err
+	}
+	zones, err := c.applicationZones(fed, res)
+	if err != nil {
+		return nil, err
+	}
+	app, err := applicationFromK8sCustomResource(*res, zones)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := c.getCredentials(res)
+	if err != nil {
+		return nil, err
+	}
+	if token, ok := credentials[secretAccessTokenKey]; ok {
+		app.AppMetaData.AccessToken = string(token)
+	}
+	return app, nil
 }
 
 func (c *k8sClient) GetArtefact(ctx context.Context, federationContextID, id string) (*Artefact, error) {
@@ -173,6 +475,66 @@ func (c *k8sClient) ListAvailabilityZones(ctx context.Context) ([]*PartnerAvaila
 	return pazs, nil
 }
 
+func (c *k8sClient) ListCandidateZones(ctx context.Context, federationContextID, appID, appProviderID string, location *models.GeoLocation) ([]CandidateZone, error) {
+	var origin geoPoint
+	if location != nil {
+		var err error
+		if origin, err = parseGeoLocation(*location); err != nil {
+			return nil, err
+		}
+	}
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return nil, err
+	}
+	app, err := c.getApplication(federationContextID, appID)
+	if err != nil {
+		return nil, err
+	}
+	if app.Spec.AppProviderId != appProviderID {
+		return nil, errors.Wrapf(ErrNotFound, "application '%s' of app provider '%s'", appID, appProviderID)
+	}
+	zones, err := c.applicationZones(fed, app)
+	if err != nil {
+		return nil, err
+	}
+	forbidden, err := getForbiddenZones(app)
+	if err != nil {
+		return nil, err
+	}
+
+	azList := &opgv1beta1.AvailabilityZoneList{}
+	if err := c.kubernetes.List(context.TODO(), azList, &k8scli.ListOptions{Namespace: c.getNamespace()}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list availability zones")
+	}
+	geolocations := make(map[string]string, len(azList.Items))
+	for _, az := range azList.Items {
+		zoneID := string(az.Spec.ZoneId)
+		if zoneID == "" {
+			zoneID = az.Name
+		}
+		geolocations[zoneID] = string(az.Spec.Geolocation)
+	}
+
+	candidates := []CandidateZone{}
+	for _, zone := range applicationZonesStatus(app, zones) {
+		if zone.OnboardStatusInfo != opgv1beta1.ApplicationStateOnboarded {
+			continue
+		}
+		if !slices.Contains(fed.Spec.AcceptedAvailabilityZones, zone.ZoneId) || slices.Contains(forbidden, zone.ZoneId) {
+			continue
+		}
+		candidates = append(candidates, CandidateZone{
+			ZoneId:      zone.ZoneId,
+			Geolocation: geolocations[zone.ZoneId],
+		})
+	}
+	if location != nil {
+		sortZonesByDistance(candidates, origin)
+	}
+	return candidates, nil
+}
+
 func (c *k8sClient) OnboardApplication(ctx context.Context, app *OnboardApplication) (*opgv1beta1.Application, error) {
 	for _, artefact := range app.artefacts() {
 		if _, err := c.GetArtefact(ctx, app.FederationContextId, artefact); err != nil {
@@ -181,6 +543,17 @@ func (c *k8sClient) OnboardApplication(ctx context.Context, app *OnboardApplicat
 			}
 		}
 	}
+	fed, err := c.getFederation(app.FederationContextId)
+	if err != nil {
+		return nil, err
+	}
+	if app.AppDeploymentZones == nil {
+		// without explicit zones the application is made available in every accepted zone
+		app.AppDeploymentZones = &fed.Spec.AcceptedAvailabilityZones
+	}
+	if err := validateAcceptedZones(fed, *app.AppDeploymentZones); err != nil {
+		return nil, err
+	}
 	opt, err := c.buildOwnerReferenceOption(app.FederationContextId)
 	if err != nil {
 		return nil, err
@@ -189,13 +562,193 @@ func (c *k8sClient) OnboardApplication(ctx context.Context, app *OnboardApplicat
 	if err != nil {
 		return nil, err
 	}
-	err = c.createK8sObject(obj)
+	err = c.createK8sObjectWithCredentials(obj, app.credentials())
 	if err != nil {
 		return nil, err
 	}
 	return obj, nil
 }
 
+func (c *k8sClient) AddApplicationZones(ctx context.Context, federationContextID, id string, zoneIDs []string) error {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return err
+	}
+	if err := validateAcceptedZones(fed, zoneIDs); err != nil {
+		return err
+	}
+	app, err := c.getApplication(federationContextID, id)
+	if err != nil {
+		return err
+	}
+	zones, err := c.applicationZones(fed, app)
+	if err != nil {
+		return err
+	}
+	for _, zone := range zones {
+		for _, zoneID := range zoneIDs {
+			if zone.ZoneId == zoneID {
+				return errors.Wrapf(ErrAlreadyExists, "application '%s' already onboarded in zone '%s'", id, zoneID)
+			}
+		}
+	}
+	if err := setApplicationZones(app, append(zones, newApplicationZones(zoneIDs)...)); err != nil {
+		return err
+	}
+	if err := c.updateK8sObject(app); err != nil {
+		return err
+	}
+	// the operator must onboard the application in the new zones
+	return c.updateK8sObjectStatus(app, string(opgv1beta1.ApplicationStatePending))
+}
+
+func (c *k8sClient) RemoveApplicationZone(ctx context.Context, federationContextID, id, zoneID string) error {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return err
+	}
+	app, err := c.getApplication(federationContextID, id)
+	if err != nil {
+		return err
+	}
+	zones, err := c.applicationZones(fed, app)
+	if err != nil {
+		return err
+	}
+	remaining := make([]ApplicationZone, 0, len(zones))
+	for _, zone := range zones {
+		if zone.ZoneId != zoneID {
+			remaining = append(remaining, zone)
+		}
+	}
+	if len(remaining) == len(zones) {
+		return errors.Wrapf(ErrNotFound, "application '%s' is not onboarded in zone '%s'", id, zoneID)
+	}
+
+	appInstances, err := c.listApplicationInstances(labels.Set{
+		opgLabel(federationContextIDLabel): federationContextID,
+		opgLabel(federationRelation):       host,
+	})
+	if err != nil {
+		return err
+	}
+	for _, appInstance := range appInstances {
+		if appInstance.Spec.AppId == id && appInstance.Spec.ZoneInfo.ZoneId == zoneID && appInstance.DeletionTimestamp.IsZero() {
+			return errors.Wrapf(ErrConflict, "application '%s' has running instances in zone '%s'", id, zoneID)
+		}
+	}
+
+	if err := setApplicationZones(app, remaining); err != nil {
+		return err
+	}
+	forbidden, err := getForbiddenZones(app)
+	if err != nil {
+		return err
+	}
+	if err := setForbiddenZones(app, removeAll(forbidden, []string{zoneID})); err != nil {
+		return err
+	}
+	return c.updateK8sObject(app)
+}
+
+func (c *k8sClient) LockUnlockApplicationZones(ctx context.Context, federationContextID, id string, locks models.LockUnlockApplicationZoneJSONBody) error {
+	fed, err := c.getFederation(federationContextID)
+	if err != nil {
+		return err
+	}
+	app, err := c.getApplication(federationContextID, id)
+	if err != nil {
+		return err
+	}
+	zones, err := c.applicationZones(fed, app)
+	if err != nil {
+		return err
+	}
+	forbidden, err := getForbiddenZones(app)
+	if err != nil {
+		return err
+	}
+	for _, lock := range locks {
+		if !slices.ContainsFunc(zones, func(zone ApplicationZone) bool { return zone.ZoneId == lock.ZoneId }) {
+			return errors.Wrapf(ErrNotFound, "application '%s' is not onboarded in zone '%s'", id, lock.ZoneId)
+		}
+		if lock.Forbid {
+			forbidden = mergeUnique(forbidden, []string{lock.ZoneId})
+		} else {
+			forbidden = removeAll(forbidden, []string{lock.ZoneId})
+		}
+	}
+	if err := setForbiddenZones(app, forbidden); err != nil {
+		return err
+	}
+	return c.updateK8sObject(app)
+}
+
+func (c *k8sClient) UpdateApplication(ctx context.Context, update *UpdateApplication) (*opgv1beta1.Application, error) {
+	if update.AppComponentSpecs == nil && update.AppUpdQoSProfile == nil {
+		return nil, errors.Wrap(ErrBadRequest, "nothing to update, appComponentSpecs or appUpdQoSProfile expected")
+	}
+	app, err := c.getApplication(update.FederationContextId, update.AppId)
+	if err != nil {
+		return nil, err
+	}
+
+	var componentSpecs []opgv1beta1.ComponentSpecRef
+	if update.AppComponentSpecs != nil {
+		componentSpecs = make([]opgv1beta1.ComponentSpecRef, len(*update.AppComponentSpecs))
+		for i, cs := range *update.AppComponentSpecs {
+			artefactID, err := c.resolveComponentArtefact(ctx, update.FederationContextId, app, cs.ComponentName, cs.ArtefactId)
+			if err != nil {
+				return nil, err
+			}
+			componentSpecs[i] = opgv1beta1.ComponentSpecRef{
+				ArtefactId: artefactID,
+			}
+		}
+	}
+
+	cr := update.updatek8sCustomResource(app, componentSpecs)
+	if err := c.updateK8sObject(cr); err != nil {
+		return nil, err
+	}
+	// the operator must reconcile the application again with the new spec
+	if err := c.updateK8sObjectStatus(cr, string(opgv1beta1.ApplicationStatePending)); err != nil {
+		return nil, err
+	}
+	return cr, nil
+}
+
+// resolveComponentArtefact returns the artefact describing the named component of the application.
+// When no artefact is given, the component is looked up in the artefacts the application already references.
+func (c *k8sClient) resolveComponentArtefact(ctx context.Context, federationContextID string, app *opgv1beta1.Application, componentName string, artefactID *string) (string, error) {
+	if artefactID != nil {
+		artefact, err := c.GetArtefact(ctx, federationContextID, *artefactID)
+		if err != nil {
+			if IsNotFoundError(err) {
+				return "", errors.Wrap(ErrBadRequest, err.Error())
+			}
+			return "", err
+		}
+		if !artefact.hasComponent(componentName) {
+			return "", errors.Wrapf(ErrBadRequest, "artefact '%s' has no component '%s'", *artefactID, componentName)
+		}
+		return *artefactID, nil
+	}
+	for _, cs := range app.Spec.ComponentSpecs {
+		artefact, err := c.GetArtefact(ctx, federationContextID, cs.ArtefactId)
+		if err != nil {
+			if IsNotFoundError(err) {
+				continue
+			}
+			return "", err
+		}
+		if artefact.hasComponent(componentName) {
+			return cs.ArtefactId, nil
+		}
+	}
+	return "", errors.Wrapf(ErrBadRequest, "component '%s' not found in the application artefacts", componentName)
+}
+
 func (c *k8sClient) RemoveApplication(ctx context.Context, federationContextID, id string) error {
 	appId := k8sCustomResourceNameFromApplicationID(federationContextID, id)
 	if err := c.kubernetes.Delete(context.TODO(), &opgv1beta1.Application{
@@ -303,6 +856,9 @@ func (c *k8sClient) UpdateApplicationStatus(ctx context.Context, federationCallb
 	if !ok {
 		return missMatchErr("application", id, federationCallbackID, &opgv1beta1.ApplicationInstance{}, obj)
 	}
+	if err := c.updateApplicationZonesStatus(res, updates); err != nil {
+		return err
+	}
 	if len(updates.StatusInfo) > 0 {
 		state := string(updates.StatusInfo[0].OnboardStatusInfo)
 		if isValidApplicationStatus(state) {
@@ -312,6 +868,37 @@ func (c *k8sClient) UpdateApplicationStatus(ctx context.Context, federationCallb
 	return nil
 }
 
+// updateApplicationZonesStatus records the onboarding state the partner reported for each zone.
+func (c *k8sClient) updateApplicationZonesStatus(app *opgv1beta1.Application, updates *models.AppStatusCallbackLinkJSONRequestBody) error {
+	if len(updates.StatusInfo) == 0 {
+		return nil
+	}
+	zones, err := getApplicationZones(app)
+	if err != nil {
+		return err
+	}
+	for _, info := range updates.StatusInfo {
+		state := opgv1beta1.ApplicationState(info.OnboardStatusInfo)
+		if !isValidApplicationStatus(string(state)) {
+			continue
+		}
+		found := false
+		for i := range zones {
+			if zones[i].ZoneId == info.ZoneId {
+				zones[i].OnboardStatusInfo = state
+				found = true
+			}
+		}
+		if !found {
+			zones = append(zones, ApplicationZone{ZoneId: info.ZoneId, OnboardStatusInfo: state})
+		}
+	}
+	if err := setApplicationZones(app, zones); err != nil {
+		return err
+	}
+	return c.updateK8sObject(app)
+}
+
 func (c *k8sClient) UpdateApplicationInstanceStatus(ctx context.Context, federationCallbackID string, updates *models.AppInstCallbackLinkJSONRequestBody) error {
 	id := updates.AppInstanceId
 	obj, err := c.getKubernetesCallbackObject(id, &opgv1beta1.ApplicationInstanceList{}, federationCallbackID)
@@ -331,17 +918,42 @@ func (c *k8sClient) UpdateApplicationInstanceStatus(ctx context.Context, federat
 	return nil
 }
 
-func (c *k8sClient) UpdateFederationStatus(ctx context.Context, federationCallbackID string, status models.Status) error {
+func (c *k8sClient) UpdateFederation(ctx context.Context, update *UpdateFederation) (*Federation, error) {
+	obj, err := c.getFederation(update.FederationContextId)
+	if err != nil {
+		return nil, err
+	}
+	cr, err := update.updatek8sCustomResource(obj)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.updateK8sObject(cr); err != nil {
+		return nil, err
+	}
+	return federationFromK8sCustomResource(cr)
+}
+
+// getGuestFederation retrieves the federation we created as guest on a partner,
+// identified by the callback id the partner uses to notify us.
+func (c *k8sClient) getGuestFederation(federationCallbackID string) (*opgv1beta1.Federation, error) {
 	obj, err := c.searchKubernetesObject(&opgv1beta1.FederationList{}, labels.Set{
 		opgLabel(federationCallbackIDLabel): federationCallbackID,
 		opgLabel(federationRelation):        guest,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	res, ok := obj.(*opgv1beta1.Federation)
+	fed, ok := obj.(*opgv1beta1.Federation)
 	if !ok {
-		return missMatchErr("federation", federationCallbackID, federationCallbackID, &opgv1beta1.Federation{}, obj)
+		return nil, missMatchErr("federation", federationCallbackID, federationCallbackID, &opgv1beta1.Federation{}, obj)
+	}
+	return fed, nil
+}
+
+func (c *k8sClient) UpdateFederationStatus(ctx context.Context, federationCallbackID string, status models.Status) error {
+	res, err := c.getGuestFederation(federationCallbackID)
+	if err != nil {
+		return err
 	}
 
 	state := string(status)
@@ -351,6 +963,99 @@ func (c *k8sClient) UpdateFederationStatus(ctx context.Context, federationCallba
 	return nil
 }
 
+func (c *k8sClient) UpdatePartnerStatus(ctx context.Context, update *UpdatePartnerStatus) error {
+	fed, err := c.getGuestFederation(update.FederationCallbackId)
+	if err != nil {
+		return err
+	}
+	if err := update.updatek8sCustomResource(fed); err != nil {
+		return err
+	}
+	// the offered zones are patched before the modification date is stored, so
+	// that a partner retrying after a failed patch is not rejected as stale
+	if update.ObjectType == models.PartnerStatusLinkJSONBodyObjectTypeZONES {
+		// the patch overwrites fed with the stored object, keep the new annotations to update them afterwards
+		annotations := maps.Clone(fed.GetAnnotations())
+		offeredZones := fed.Status.OfferedAvailabilityZones
+		if err := c.patchK8sObjectStatus(fed, map[string]any{"offeredAvailabilityZones": offeredZones}); err != nil {
+			return err
+		}
+		fed.SetAnnotations(annotations)
+	}
+	return c.updateK8sObject(fed)
+}
+
+func (c *k8sClient) GetPartnerDetails(ctx context.Context, federationCallbackID string) (*PartnerDetails, error) {
+	fed, err := c.getGuestFederation(federationCallbackID)
+	if err != nil {
+		return nil, err
+	}
+	details, err := getPartnerDetails(fed)
+	if err != nil {
+		return nil, err
+	}
+	if details.ZoneStatus, err = getZoneStatuses(fed); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+func (c *k8sClient) UpdateZoneResources(ctx context.Context, federationCallbackID string, updates *models.AvailZoneNotifLinkJSONRequestBody) error {
+	fed, err := c.getGuestFederation(federationCallbackID)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(fed.Status.OfferedAvailabilityZones, func(z opgv1beta1.ZoneDetails) bool { return z.ZoneId == updates.ZoneId }) {
+		return errors.Wrapf(ErrNotFound, "zone '%s' is not offered in federation '%s'", updates.ZoneId, federationCallbackID)
+	}
+	res := &ZoneResources{
+		AvailZoneNotifLinkJSONBody: models.AvailZoneNotifLinkJSONBody(*updates),
+		FederationCallbackId:       federationCallbackID,
+		UpdateTime:                 time.Now().UTC(),
+	}
+
+	obj, err := c.getZoneResources(federationCallbackID, updates.ZoneId)
+	if IsNotFoundError(err) {
+		obj, err := res.k8sCustomResource(c.getNamespace(), WithOwnerReference(fed, c.getScheme()))
+		if err != nil {
+			return err
+		}
+		return c.createK8sObject(obj)
+	}
+	if err != nil {
+		return err
+	}
+	if err := setConfigMapJSON(obj, res); err != nil {
+		return err
+	}
+	return c.updateK8sObject(obj)
+}
+
+func (c *k8sClient) getZoneResources(federationCallbackID, zoneID string) (*corev1.ConfigMap, error) {
+	obj, err := c.searchKubernetesObject(&corev1.ConfigMapList{}, labels.Set{
+		opgLabel(federationCallbackIDLabel): federationCallbackID,
+		opgLabel(federationRelation):        guest,
+		opgLabel(kindLabel):                 zoneResourcesKind,
+		opgLabel(zoneIDLabel):               zoneID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		return nil, missMatchErr("zone resources", zoneID, federationCallbackID, &corev1.ConfigMap{}, obj)
+	}
+	return cm, nil
+}
+
+func (c *k8sClient) GetZoneResources(ctx context.Context, federationCallbackID, zoneID string) (*ZoneResources, error) {
+	obj, err := c.getZoneResources(federationCallbackID, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	return zoneResourcesFromK8sCustomResource(obj)
+}
+
 func (c *k8sClient) UploadArtefact(ctx context.Context, artefact *UploadArtefact) (*opgv1beta1.Artefact, error) {
 	for _, file := range artefact.files() {
 		if _, err := c.GetFile(ctx, artefact.FederationContextId, file); err != nil {
@@ -383,7 +1088,7 @@ func (c *k8sClient) UploadFile(ctx context.Context, file *UploadFile) (*opgv1bet
 	if err != nil {
 		return nil, err
 	}
-	err = c.createK8sObject(obj)
+	err = c.createK8sObjectWithCredentials(obj, file.credentials())
 	if err != nil {
 		return nil, err
 	}
@@ -398,15 +1103,17 @@ func (c *k8sClient) getScheme() *runtime.Scheme {
 	return c.kubernetes.Scheme()
 }
 
-func (c *k8sClient) GetApplicationInstanceDetails(ctx context.Context, federationContextID, id string) (*ApplicationInstanceDetails, error) {
-	//return nil, errors.Errorf("method not implemented")
-	application, err := c.getKubernetesObject(id, &opgv1beta1.ApplicationInstanceList{}, federationContextID)
+func (c *k8sClient) GetApplicationInstanceDetails(ctx context.Context, federationContextID, appID, id, zoneID string) (*ApplicationInstanceDetails, error) {
+	obj, err := c.getKubernetesObject(id, &opgv1beta1.ApplicationInstanceList{}, federationContextID)
 	if err != nil {
 		return nil, err
 	}
-	res, ok := application.(*opgv1beta1.ApplicationInstance)
+	res, ok := obj.(*opgv1beta1.ApplicationInstance)
 	if !ok {
-		return nil, missMatchErr("application instance", id, federationContextID, &opgv1beta1.ApplicationInstance{}, application)
+		return nil, missMatchErr("application instance", id, federationContextID, &opgv1beta1.ApplicationInstance{}, obj)
+	}
+	if res.Spec.AppId != appID || res.Spec.ZoneInfo.ZoneId != zoneID {
+		return nil, errors.Wrapf(ErrNotFound, "application instance '%s' of application '%s' in zone '%s'", id, appID, zoneID)
 	}
-	return applicationInstanceFromK8sCustomResource(id, *res)
+	return applicationInstanceFromK8sCustomResource(*res), nil
 }
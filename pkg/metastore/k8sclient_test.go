@@ -0,0 +1,589 @@
+package metastore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/icza/gog"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	k8scli "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	opgv1beta1 "github.com/neonephos-katalis/opg-ewbi-operator/api/v1beta1"
+)
+
+const testNamespace = "test"
+
+// newTestK8sClient returns a client backed by a fake Kubernetes API holding
+// objects, whose calls can be intercepted with funcs.
+func newTestK8sClient(funcs interceptor.Funcs, objects ...k8scli.Object) *k8sClient {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(opgv1beta1.AddToScheme(scheme))
+	kubernetes := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&opgv1beta1.Federation{}, &opgv1beta1.Application{}).
+		WithInterceptorFuncs(funcs).
+		Build()
+	return NewK8sClient(kubernetes, testNamespace)
+}
+
+func newHostFederation(federationContextID string, acceptedZones ...string) *opgv1beta1.Federation {
+	return &opgv1beta1.Federation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      federationContextID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				opgLabel(federationContextIDLabel): federationContextID,
+				opgLabel(idLabel):                  federationContextID,
+				opgLabel(federationRelation):       host,
+			},
+		},
+		Spec: opgv1beta1.FederationSpec{AcceptedAvailabilityZones: acceptedZones},
+	}
+}
+
+func newApplication(federationContextID, appID string, zones []ApplicationZone) *opgv1beta1.Application {
+	app := &opgv1beta1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      appID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				opgLabel(federationContextIDLabel): federationContextID,
+				opgLabel(idLabel):                  appID,
+				opgLabel(federationRelation):       host,
+			},
+		},
+		Spec: opgv1beta1.ApplicationSpec{AppProviderId: "provider"},
+	}
+	if zones != nil {
+		utilruntime.Must(setApplicationZones(app, zones))
+	}
+	return app
+}
+
+func newApplicationInstance(federationContextID, appID, instanceID, zoneID string) *opgv1beta1.ApplicationInstance {
+	body := &models.InstallAppJSONBody{AppId: appID, AppInstanceId: instanceID, AppProviderId: "provider"}
+	body.ZoneInfo.ZoneId = zoneID
+	obj, err := (&ApplicationInstance{InstallAppJSONBody: body, FederationContextId: federationContextID}).k8sCustomResource(testNamespace)
+	utilruntime.Must(err)
+	return obj
+}
+
+func newArtefact(federationContextID, artefactID string, components ...string) *opgv1beta1.Artefact {
+	artefact := &opgv1beta1.Artefact{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      artefactID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				opgLabel(federationContextIDLabel): federationContextID,
+				opgLabel(idLabel):                  artefactID,
+				opgLabel(federationRelation):       host,
+			},
+		},
+	}
+	for _, name := range components {
+		artefact.Spec.ComponentSpec = append(artefact.Spec.ComponentSpec, opgv1beta1.ComponentSpec{Name: name})
+	}
+	return artefact
+}
+
+func newGuestFederation(federationCallbackID string) *opgv1beta1.Federation {
+	return &opgv1beta1.Federation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      federationCallbackID,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				opgLabel(federationCallbackIDLabel): federationCallbackID,
+				opgLabel(federationRelation):        guest,
+			},
+		},
+	}
+}
+
+func Test_UpdatePartnerStatus(t *testing.T) {
+	date := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	update := &UpdatePartnerStatus{
+		FederationCallbackId: "callback",
+		PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+			ModificationDate: date,
+			ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeZONES,
+			OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeADD,
+			AddZones:         &[]models.ZoneDetails{{ZoneId: "zone-1"}},
+		},
+	}
+
+	t.Run("Failed status patch can be retried", func(t *testing.T) {
+		failPatch := true
+		c := newTestK8sClient(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, client k8scli.Client, subResourceName string, obj k8scli.Object, patch k8scli.Patch, opts ...k8scli.SubResourcePatchOption) error {
+				if failPatch {
+					return errors.New("patch failed")
+				}
+				return client.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+			},
+		}, newGuestFederation("callback"))
+
+		require.Error(t, c.UpdatePartnerStatus(context.Background(), update))
+		fed, err := c.getGuestFederation("callback")
+		require.NoError(t, err)
+		require.Empty(t, getAnnotation(fed, modificationDateAnnotation))
+
+		failPatch = false
+		require.NoError(t, c.UpdatePartnerStatus(context.Background(), update))
+		fed, err = c.getGuestFederation("callback")
+		require.NoError(t, err)
+		require.Equal(t, "zone-1", fed.Status.OfferedAvailabilityZones[0].ZoneId)
+		require.NotEmpty(t, getAnnotation(fed, modificationDateAnnotation))
+	})
+
+	t.Run("Partner details do not patch the status", func(t *testing.T) {
+		c := newTestK8sClient(interceptor.Funcs{
+			SubResourcePatch: func(ctx context.Context, client k8scli.Client, subResourceName string, obj k8scli.Object, patch k8scli.Patch, opts ...k8scli.SubResourcePatchOption) error {
+				return errors.New("unexpected status patch")
+			},
+		}, newGuestFederation("callback"))
+
+		require.NoError(t, c.UpdatePartnerStatus(context.Background(), &UpdatePartnerStatus{
+			FederationCallbackId: "callback",
+			PartnerStatusLinkJSONRequestBody: &models.PartnerStatusLinkJSONRequestBody{
+				ModificationDate: date,
+				ObjectType:       models.PartnerStatusLinkJSONBodyObjectTypeLCMSERVICE,
+				OperationType:    models.PartnerStatusLinkJSONBodyOperationTypeUPDATE,
+				LcmSvcEndPoint:   &models.ServiceEndpoint{Port: 8443},
+			},
+		}))
+	})
+}
+
+func Test_RemoveAvailabilityZones(t *testing.T) {
+	c := newTestK8sClient(interceptor.Funcs{},
+		newHostFederation("fed", "zone-1", "zone-2"),
+		newApplicationInstance("fed", "app", "instance", "zone-2"),
+	)
+	ctx := context.Background()
+
+	err := c.RemoveAvailabilityZones(ctx, "fed", []string{"zone-3"})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	err = c.RemoveAvailabilityZones(ctx, "fed", []string{"zone-1", "zone-2"})
+	require.ErrorIs(t, err, ErrConflict)
+	fed, err := c.getFederation("fed")
+	require.NoError(t, err)
+	require.Equal(t, []string{"zone-1", "zone-2"}, fed.Spec.AcceptedAvailabilityZones)
+
+	require.NoError(t, c.RemoveAvailabilityZones(ctx, "fed", []string{"zone-1"}))
+	fed, err = c.getFederation("fed")
+	require.NoError(t, err)
+	require.Equal(t, []string{"zone-2"}, fed.Spec.AcceptedAvailabilityZones)
+
+	err = c.RemoveAvailabilityZones(ctx, "unknown", []string{"zone-1"})
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func Test_ListApplicationInstances(t *testing.T) {
+	ready := newApplicationInstance("fed", "app", "ready", "zone-b")
+	ready.Status.State = opgv1beta1.ApplicationInstanceStateReady
+	otherProvider := newApplicationInstance("fed", "app", "other-provider", "zone-a")
+	otherProvider.Labels[opgLabel(appProviderIDLabel)] = "other"
+	c := newTestK8sClient(interceptor.Funcs{},
+		newApplicationInstance("fed", "app", "pending", "zone-b"),
+		ready,
+		newApplicationInstance("fed", "app", "zone-a", "zone-a"),
+		newApplicationInstance("fed", "other", "other-app", "zone-a"),
+		newApplicationInstance("other", "app", "other-fed", "zone-a"),
+		otherProvider,
+	)
+
+	res, err := c.ListApplicationInstances(context.Background(), "fed", "app", "provider")
+	require.NoError(t, err)
+	require.Len(t, res.GetAllAppInstances200JSONResponse, 2)
+
+	zoneA := res.GetAllAppInstances200JSONResponse[0]
+	require.Equal(t, "zone-a", zoneA.ZoneId)
+	require.Equal(t, []appInstanceInfo{{AppInstIdentifier: "zone-a", AppInstanceState: models.InstanceStatePENDING}}, zoneA.AppInstanceInfo)
+
+	zoneB := res.GetAllAppInstances200JSONResponse[1]
+	require.Equal(t, "zone-b", zoneB.ZoneId)
+	require.ElementsMatch(t, []appInstanceInfo{
+		{AppInstIdentifier: "pending", AppInstanceState: models.InstanceStatePENDING},
+		{AppInstIdentifier: "ready", AppInstanceState: models.InstanceStateREADY},
+	}, zoneB.AppInstanceInfo)
+
+	res, err = c.ListApplicationInstances(context.Background(), "fed", "unknown", "provider")
+	require.NoError(t, err)
+	require.Empty(t, res.GetAllAppInstances200JSONResponse)
+}
+
+func Test_GetApplicationInstanceDetails(t *testing.T) {
+	ready := newApplicationInstance("fed", "app", "ready", "zone-1")
+	ready.Status.State = opgv1beta1.ApplicationInstanceStateReady
+	ready.Status.AccessPointInfo = []opgv1beta1.AccessPointInfo{{
+		InterfaceId:  "http",
+		AccessPoints: opgv1beta1.AccessPoints{Port: 8080, Fqdn: "app.example.com", Ipv4Addresses: []string{"10.0.0.1"}},
+	}}
+	c := newTestK8sClient(interceptor.Funcs{}, ready, newApplicationInstance("fed", "app", "pending", "zone-1"))
+	ctx := context.Background()
+
+	details, err := c.GetApplicationInstanceDetails(ctx, "fed", "app", "ready", "zone-1")
+	require.NoError(t, err)
+	require.Equal(t, models.InstanceStateREADY, *details.AppInstanceState)
+	require.NotNil(t, details.AccessPointInfo)
+	require.Len(t, *details.AccessPointInfo, 1)
+	accessPoint := (*details.AccessPointInfo)[0]
+	require.Equal(t, "http", accessPoint.InterfaceId)
+	require.Equal(t, 8080, accessPoint.AccessPoints.Port)
+	require.Equal(t, "app.example.com", *accessPoint.AccessPoints.Fqdn)
+	require.Equal(t, []models.Ipv4Addr{"10.0.0.1"}, *accessPoint.AccessPoints.Ipv4Addresses)
+	require.Nil(t, accessPoint.AccessPoints.Ipv6Addresses)
+
+	// instances without a reported status are pending
+	details, err = c.GetApplicationInstanceDetails(ctx, "fed", "app", "pending", "zone-1")
+	require.NoError(t, err)
+	require.Equal(t, models.InstanceStatePENDING, *details.AppInstanceState)
+	require.Nil(t, details.AccessPointInfo)
+
+	tests := []struct {
+		name   string
+		appID  string
+		id     string
+		zoneID string
+	}{
+		{"Other application", "other", "ready", "zone-1"},
+		{"Other zone", "app", "ready", "zone-2"},
+		{"Unknown instance", "app", "unknown", "zone-1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := c.GetApplicationInstanceDetails(ctx, "fed", tt.appID, tt.id, tt.zoneID)
+			require.ErrorIs(t, err, ErrNotFound)
+		})
+	}
+}
+
+func Test_CreateFederation(t *testing.T) {
+	partner := &opgv1beta1.Federation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "partner",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				opgLabel(clientIDLabel):      "partner",
+				opgLabel(federationRelation): host,
+			},
+		},
+	}
+	input := &Federation{
+		FederationRequestData: &models.FederationRequestData{
+			InitialDate:              time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			OrigOPFixedNetworkCodes:  &[]string{},
+			OrigOPMobileNetworkCodes: &models.MobileNetworkIds{Mcc: gog.Ptr("214"), Mncs: &[]string{"01"}},
+			PartnerCallbackCredentials: &models.CallbackCredentials{
+				ClientId:     "callback-client",
+				ClientSecret: "callback-secret",
+				TokenUrl:     "https://partner.example.com/oauth2/token",
+			},
+		},
+		ClientCredentials:   ClientCredentials{ClientID: "partner"},
+		FederationContextId: "fed",
+	}
+
+	failSecret := true
+	c := newTestK8sClient(interceptor.Funcs{
+		Create: func(ctx context.Context, client k8scli.WithWatch, obj k8scli.Object, opts ...k8scli.CreateOption) error {
+			if _, ok := obj.(*corev1.Secret); ok && failSecret {
+				return errors.New("create failed")
+			}
+			return client.Create(ctx, obj, opts...)
+		},
+	}, partner)
+
+	_, err := c.CreateFederation(context.Background(), input)
+	require.Error(t, err)
+
+	// the federation is left unset so that the partner can retry
+	failSecret = false
+	_, err = c.CreateFederation(context.Background(), input)
+	require.NoError(t, err)
+
+	fed := &opgv1beta1.Federation{}
+	require.NoError(t, c.kubernetes.Get(context.Background(), types.NamespacedName{Name: "partner", Namespace: testNamespace}, fed))
+	credentials, err := c.getCredentials(fed)
+	require.NoError(t, err)
+	require.Equal(t, "callback-secret", string(credentials[secretClientSecretKey]))
+
+	callbackCredentials, err := c.GetPartnerCallbackCredentials(context.Background(), "fed")
+	require.NoError(t, err)
+	require.Equal(t, input.PartnerCallbackCredentials, callbackCredentials)
+}
+
+func Test_UpdateApplication(t *testing.T) {
+	newClient := func() *k8sClient {
+		app := newApplication("fed", "app", nil)
+		app.Spec.ComponentSpecs = []opgv1beta1.ComponentSpecRef{{ArtefactId: "current"}}
+		app.Status.State = opgv1beta1.ApplicationStateOnboarded
+		return newTestK8sClient(interceptor.Funcs{},
+			newHostFederation("fed"), app,
+			newArtefact("fed", "current", "web"),
+			newArtefact("fed", "other", "api"),
+		)
+	}
+	components := func(items ...models.UpdateApplicationJSONBody_AppComponentSpecs_Item) *UpdateApplication {
+		return &UpdateApplication{
+			UpdateApplicationJSONBody: &models.UpdateApplicationJSONBody{AppComponentSpecs: &items},
+			FederationContextId:       "fed",
+			AppId:                     "app",
+		}
+	}
+
+	t.Run("QoS profile", func(t *testing.T) {
+		c := newClient()
+		latency := models.UpdateApplicationJSONBodyAppUpdQoSProfileLatencyConstraints("LOW")
+		_, err := c.UpdateApplication(context.Background(), &UpdateApplication{
+			UpdateApplicationJSONBody: &models.UpdateApplicationJSONBody{
+				AppUpdQoSProfile: &models.UpdateApplicationJSONBody_AppUpdQoSProfile{
+					LatencyConstraints:  &latency,
+					NoOfUsersPerAppInst: gog.Ptr(10),
+				},
+			},
+			FederationContextId: "fed",
+			AppId:               "app",
+		})
+		require.NoError(t, err)
+		app, err := c.getApplication("fed", "app")
+		require.NoError(t, err)
+		require.Equal(t, "LOW", app.Spec.QoSProfile.LatencyConstraints)
+		require.Equal(t, int64(10), app.Spec.QoSProfile.UsersPerAppInst)
+		require.Equal(t, []opgv1beta1.ComponentSpecRef{{ArtefactId: "current"}}, app.Spec.ComponentSpecs)
+		// the operator reconciles the application again
+		require.Equal(t, opgv1beta1.ApplicationStatePending, app.Status.State)
+	})
+
+	t.Run("Component specs", func(t *testing.T) {
+		c := newClient()
+		_, err := c.UpdateApplication(context.Background(), components(
+			models.UpdateApplicationJSONBody_AppComponentSpecs_Item{ComponentName: "web"},
+			models.UpdateApplicationJSONBody_AppComponentSpecs_Item{ComponentName: "api", ArtefactId: gog.Ptr("other")},
+		))
+		require.NoError(t, err)
+		app, err := c.getApplication("fed", "app")
+		require.NoError(t, err)
+		require.Equal(t, []opgv1beta1.ComponentSpecRef{{ArtefactId: "current"}, {ArtefactId: "other"}}, app.Spec.ComponentSpecs)
+	})
+
+	tests := []struct {
+		name   string
+		update *UpdateApplication
+		err    error
+	}{
+		{
+			name:   "Nothing to update",
+			update: &UpdateApplication{UpdateApplicationJSONBody: &models.UpdateApplicationJSONBody{}, FederationContextId: "fed", AppId: "app"},
+			err:    ErrBadRequest,
+		},
+		{
+			name:   "Unknown artefact",
+			update: components(models.UpdateApplicationJSONBody_AppComponentSpecs_Item{ComponentName: "web", ArtefactId: gog.Ptr("unknown")}),
+			err:    ErrBadRequest,
+		},
+		{
+			name:   "Component not in artefact",
+			update: components(models.UpdateApplicationJSONBody_AppComponentSpecs_Item{ComponentName: "web", ArtefactId: gog.Ptr("other")}),
+			err:    ErrBadRequest,
+		},
+		{
+			name:   "Component not in application artefacts",
+			update: components(models.UpdateApplicationJSONBody_AppComponentSpecs_Item{ComponentName: "api"}),
+			err:    ErrBadRequest,
+		},
+		{
+			name: "Unknown application",
+			update: &UpdateApplication{
+				UpdateApplicationJSONBody: &models.UpdateApplicationJSONBody{AppUpdQoSProfile: &models.UpdateApplicationJSONBody_AppUpdQoSProfile{}},
+				FederationContextId:       "fed",
+				AppId:                     "unknown",
+			},
+			err: ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newClient().UpdateApplication(context.Background(), tt.update)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func Test_ListCandidateZones(t *testing.T) {
+	app := newApplication("fed", "app", []ApplicationZone{
+		{ZoneId: "onboarded", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+		{ZoneId: "pending", OnboardStatusInfo: opgv1beta1.ApplicationStatePending},
+		{ZoneId: "failed", OnboardStatusInfo: opgv1beta1.ApplicationStateFailed},
+		{ZoneId: "not-accepted", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+	})
+	c := newTestK8sClient(interceptor.Funcs{}, newHostFederation("fed", "onboarded", "pending", "failed"), app)
+
+	zones, err := c.ListCandidateZones(context.Background(), "fed", "app", "provider", nil)
+	require.NoError(t, err)
+	require.Equal(t, []CandidateZone{{ZoneId: "onboarded"}}, zones)
+}
+
+func Test_AddApplicationInstance_forbiddenZone(t *testing.T) {
+	app := newApplication("fed", "app", []ApplicationZone{{ZoneId: "zone-1", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded}})
+	c := newTestK8sClient(interceptor.Funcs{}, newHostFederation("fed", "zone-1"), app)
+	ctx := context.Background()
+	install := func(instanceID string) error {
+		_, err := c.AddApplicationInstance(ctx, &ApplicationInstance{
+			InstallAppJSONBody: &models.InstallAppJSONBody{
+				AppId:         "app",
+				AppInstanceId: instanceID,
+				AppProviderId: "provider",
+				ZoneInfo: struct {
+					FlavourId           models.FlavourId                                      `json:"flavourId"`
+					ResPool             *string                                               `json:"resPool,omitempty"`
+					ResourceConsumption *models.InstallAppJSONBodyZoneInfoResourceConsumption `json:"resourceConsumption,omitempty"`
+					ZoneId              models.ZoneIdentifier                                 `json:"zoneId"`
+				}{FlavourId: "small", ZoneId: "zone-1"},
+			},
+			FederationContextId: "fed",
+		})
+		return err
+	}
+
+	require.NoError(t, c.LockUnlockApplicationZones(ctx, "fed", "app", models.LockUnlockApplicationZoneJSONBody{
+		{ZoneId: "zone-1", Forbid: true},
+	}))
+	require.ErrorIs(t, install("instance-1"), ErrConflict)
+
+	require.NoError(t, c.LockUnlockApplicationZones(ctx, "fed", "app", models.LockUnlockApplicationZoneJSONBody{
+		{ZoneId: "zone-1", Forbid: false},
+	}))
+	require.NoError(t, install("instance-1"))
+}
+
+func Test_RemoveApplicationZone(t *testing.T) {
+	app := newApplication("fed", "app", []ApplicationZone{
+		{ZoneId: "zone-1", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+		{ZoneId: "zone-2", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+	})
+	utilruntime.Must(setForbiddenZones(app, []string{"zone-1"}))
+	c := newTestK8sClient(interceptor.Funcs{},
+		newHostFederation("fed", "zone-1", "zone-2"), app,
+		newApplicationInstance("fed", "app", "instance", "zone-2"),
+		newApplicationInstance("fed", "other", "other-instance", "zone-1"),
+	)
+	ctx := context.Background()
+
+	err := c.RemoveApplicationZone(ctx, "fed", "app", "zone-3")
+	require.ErrorIs(t, err, ErrNotFound)
+	err = c.RemoveApplicationZone(ctx, "fed", "unknown", "zone-1")
+	require.ErrorIs(t, err, ErrNotFound)
+	err = c.RemoveApplicationZone(ctx, "fed", "app", "zone-2")
+	require.ErrorIs(t, err, ErrConflict)
+
+	// the instances of other applications do not prevent the deboarding
+	require.NoError(t, c.RemoveApplicationZone(ctx, "fed", "app", "zone-1"))
+	res, err := c.getApplication("fed", "app")
+	require.NoError(t, err)
+	zones, err := getApplicationZones(res)
+	require.NoError(t, err)
+	require.Equal(t, []ApplicationZone{{ZoneId: "zone-2", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded}}, zones)
+	forbidden, err := getForbiddenZones(res)
+	require.NoError(t, err)
+	require.Empty(t, forbidden)
+}
+
+func Test_ApplicationZones_withoutDeploymentZones(t *testing.T) {
+	// applications onboarded before their zones were recorded
+	newClient := func() *k8sClient {
+		app := newApplication("fed", "app", nil)
+		app.Status.State = opgv1beta1.ApplicationStateOnboarded
+		return newTestK8sClient(interceptor.Funcs{}, newHostFederation("fed", "zone-1", "zone-2"), app)
+	}
+
+	t.Run("Lock accepted zone", func(t *testing.T) {
+		c := newClient()
+		require.NoError(t, c.LockUnlockApplicationZones(context.Background(), "fed", "app", models.LockUnlockApplicationZoneJSONBody{
+			{ZoneId: "zone-1", Forbid: true},
+		}))
+		app, err := c.getApplication("fed", "app")
+		require.NoError(t, err)
+		forbidden, err := getForbiddenZones(app)
+		require.NoError(t, err)
+		require.Equal(t, []string{"zone-1"}, forbidden)
+	})
+
+	t.Run("Remove accepted zone", func(t *testing.T) {
+		c := newClient()
+		require.NoError(t, c.RemoveApplicationZone(context.Background(), "fed", "app", "zone-1"))
+		app, err := c.getApplication("fed", "app")
+		require.NoError(t, err)
+		zones, err := getApplicationZones(app)
+		require.NoError(t, err)
+		require.Equal(t, []ApplicationZone{{ZoneId: "zone-2", OnboardStatusInfo: opgv1beta1.ApplicationStatePending}}, zones)
+
+		err = c.RemoveApplicationZone(context.Background(), "fed", "app", "zone-1")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Remove unknown zone", func(t *testing.T) {
+		err := newClient().RemoveApplicationZone(context.Background(), "fed", "app", "zone-3")
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Get application", func(t *testing.T) {
+		app, err := newClient().GetApplication(context.Background(), "fed", "app")
+		require.NoError(t, err)
+		require.Equal(t, []models.ZoneIdentifier{"zone-1", "zone-2"}, app.AppDeploymentZones)
+		require.Equal(t, []ApplicationZone{
+			{ZoneId: "zone-1", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+			{ZoneId: "zone-2", OnboardStatusInfo: opgv1beta1.ApplicationStateOnboarded},
+		}, app.DeploymentZones)
+	})
+
+	t.Run("Candidate zones", func(t *testing.T) {
+		zones, err := newClient().ListCandidateZones(context.Background(), "fed", "app", "provider", nil)
+		require.NoError(t, err)
+		require.Equal(t, []CandidateZone{{ZoneId: "zone-1"}, {ZoneId: "zone-2"}}, zones)
+	})
+}
+
+func Test_CreateResourcePoolReservation(t *testing.T) {
+	c := newTestK8sClient(interceptor.Funcs{}, newGuestFederation("callback"))
+	ctx := context.Background()
+
+	// the partner notifies the grant before the request is recorded
+	require.NoError(t, c.UpdateResourcePoolReservationGrant(ctx, "callback", &models.ResourceReservationCallbackLinkJSONRequestBody{
+		PoolId: "pool",
+		ZoneId: "zone",
+		GrantedFlavours: []struct {
+			FlavourId  models.FlavourId `json:"flavourId"`
+			NumFlavour int32            `json:"numFlavour"`
+		}{{FlavourId: "small", NumFlavour: 2}},
+	}))
+	r, err := c.GetResourcePoolReservation(ctx, "callback", "pool")
+	require.NoError(t, err)
+	require.Equal(t, ResourcePoolReservationUnrequested, r.State())
+
+	require.NoError(t, c.CreateResourcePoolReservation(ctx, &ResourcePoolReservation{
+		FederationCallbackId: "callback",
+		PoolId:               "pool",
+		RequestedFlavours:    []ResourcePoolFlavour{{FlavourId: "small", NumFlavour: 4}},
+	}))
+	r, err = c.GetResourcePoolReservation(ctx, "callback", "pool")
+	require.NoError(t, err)
+	require.Equal(t, ResourcePoolReservationPartiallyGranted, r.State())
+
+	err = c.CreateResourcePoolReservation(ctx, &ResourcePoolReservation{FederationCallbackId: "callback", PoolId: "pool"})
+	require.ErrorIs(t, err, ErrAlreadyExists)
+}
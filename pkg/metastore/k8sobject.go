@@ -7,6 +7,7 @@ import (
 
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -107,6 +108,19 @@ func (c *k8sClient) searchKubernetesObjects(objectList k8scli.ObjectList, search
 	return objectList, nil
 }
 
+// listApplicationInstances returns the application instances matching the specified labels.
+func (c *k8sClient) listApplicationInstances(searchLabels labels.Set) ([]opgv1beta1.ApplicationInstance, error) {
+	objectList, err := c.searchKubernetesObjects(&opgv1beta1.ApplicationInstanceList{}, searchLabels)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := objectList.(*opgv1beta1.ApplicationInstanceList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected list type %T: %w", objectList, ErrInternal)
+	}
+	return list.Items, nil
+}
+
 func (c *k8sClient) updateK8sObject(object k8scli.Object) error {
 	if err := c.kubernetes.Update(context.TODO(), object, &k8scli.UpdateOptions{}); err != nil {
 		return errors.Wrapf(err, "unable to update object %T", object)
@@ -128,6 +142,24 @@ func (c *k8sClient) updateK8sObjectStatus(object k8scli.Object, status string) e
 	return nil
 }
 
+// patchK8sObjectStatus merges the specified fields into the status of the object.
+func (c *k8sClient) patchK8sObjectStatus(object k8scli.Object, status map[string]any) error {
+	patch, err := json.Marshal(map[string]any{"status": status})
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal status patch")
+	}
+
+	if err := c.kubernetes.Status().Patch(
+		context.TODO(),
+		object,
+		k8scli.RawPatch(types.MergePatchType, patch),
+		&k8scli.SubResourcePatchOptions{},
+	); err != nil {
+		return errors.Wrapf(err, "unable to update object %T", object)
+	}
+	return nil
+}
+
 func (c *k8sClient) updateK8sObjectAppInstStatus(object k8scli.Object, updates *models.AppInstCallbackLinkJSONRequestBody) (err error) {
 	info := updates.AppInstanceInfo
 	var patch struct {
@@ -190,6 +222,11 @@ func getFirstItemFromObjectList(list k8scli.ObjectList) (k8scli.Object, error) {
 			return nil, fmt.Errorf("no '%s' items found", kind)
 		}
 		return &typedList.Items[0], nil
+	case *corev1.ConfigMapList:
+		if len(typedList.Items) == 0 {
+			return nil, fmt.Errorf("no '%s' items found", kind)
+		}
+		return &typedList.Items[0], nil
 	default:
 		return nil, fmt.Errorf("unsupported list type: %T", list)
 	}
@@ -209,6 +246,8 @@ func getListKind(list k8scli.ObjectList) string {
 		return federationKind
 	case *opgv1beta1.FileList:
 		return fileKind
+	case *corev1.ConfigMapList:
+		return configMapKind
 	default:
 		return "Unknown"
 	}
@@ -228,6 +267,8 @@ func getObjectKind(obj k8scli.Object) string {
 		return federationKind
 	case *opgv1beta1.File:
 		return fileKind
+	case *corev1.ConfigMap, *corev1.Secret:
+		return obj.GetLabels()[opgLabel(kindLabel)]
 	default:
 		return "Unknown"
 	}
@@ -7,22 +7,32 @@ const (
 type labelKey string
 
 const (
+	appIDLabel                labelKey = "app-id"
+	appProviderIDLabel        labelKey = "app-provider-id"
 	clientIDLabel             labelKey = "origin-client-id"
 	federationCallbackIDLabel labelKey = "federation-callback-id"
 	federationContextIDLabel  labelKey = "federation-context-id"
 	federationRelation        labelKey = "federation-relation"
 	idLabel                   labelKey = "id"
 	kindLabel                 labelKey = "kind"
+	zoneIDLabel               labelKey = "zone-id"
 )
 
 const (
-	applicationInstanceKind   string = "applicationInstance"
-	applicationInstancePrefix string = "application-instance"
-	applicationKind           string = "application"
-	artefactKind              string = "artefact"
-	availabilityZoneKind      string = "availabilityZone"
-	federationKind            string = "federation"
-	fileKind                  string = "file"
+	applicationInstanceKind       string = "applicationInstance"
+	applicationInstancePrefix     string = "application-instance"
+	applicationKind               string = "application"
+	artefactKind                  string = "artefact"
+	availabilityZoneKind          string = "availabilityZone"
+	credentialsKind               string = "credentials"
+	federationKind                string = "federation"
+	fileKind                      string = "file"
+	resourcePoolKind              string = "resourcePool"
+	resourcePoolPrefix            string = "resource-pool"
+	resourcePoolReservationKind   string = "resourcePoolReservation"
+	resourcePoolReservationPrefix string = "resource-pool-reservation"
+	zoneResourcesKind             string = "zoneResources"
+	zoneResourcesPrefix           string = "zone-resources"
 )
 
 const (
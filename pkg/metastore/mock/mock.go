@@ -11,7 +11,15 @@ type FakeMetaStoreClient struct {
 	metastore.Client
 	ListAvailabilityZonesFunc func() ([]*metastore.PartnerAvailabilityZone, error)
 	CreateFederationFunc      func(federation *metastore.Federation) (*metastore.Federation, error)
+	GetFederationFunc         func(federationContextID string) (*metastore.Federation, error)
+	UpdateFederationFunc      func(update *metastore.UpdateFederation) (*metastore.Federation, error)
 	GetClientCredentialsFunc  func(clientID string) (metastore.ClientCredentials, error)
+	GetPartnerDetailsFunc     func(federationCallbackID string) (*metastore.PartnerDetails, error)
+
+	CreateResourcePoolReservationFunc func(reservation *metastore.ResourcePoolReservation) error
+
+	GetFederationClientIDFunc      func(federationContextID string) (string, error)
+	GetGuestFederationClientIDFunc func(federationCallbackID string) (string, error)
 }
 
 func (f *FakeMetaStoreClient) ListAvailabilityZones(ctx context.Context) ([]*metastore.PartnerAvailabilityZone, error) {
@@ -22,6 +30,30 @@ func (f *FakeMetaStoreClient) CreateFederation(ctx context.Context, federation *
 	return f.CreateFederationFunc(federation)
 }
 
+func (f *FakeMetaStoreClient) GetFederation(ctx context.Context, federationContextID string) (*metastore.Federation, error) {
+	return f.GetFederationFunc(federationContextID)
+}
+
+func (f *FakeMetaStoreClient) UpdateFederation(ctx context.Context, update *metastore.UpdateFederation) (*metastore.Federation, error) {
+	return f.UpdateFederationFunc(update)
+}
+
 func (f *FakeMetaStoreClient) GetClientCredentials(ctx context.Context, clientID string) (metastore.ClientCredentials, error) {
 	return f.GetClientCredentialsFunc(clientID)
 }
+
+func (f *FakeMetaStoreClient) GetPartnerDetails(ctx context.Context, federationCallbackID string) (*metastore.PartnerDetails, error) {
+	return f.GetPartnerDetailsFunc(federationCallbackID)
+}
+
+func (f *FakeMetaStoreClient) GetFederationClientID(ctx context.Context, federationContextID string) (string, error) {
+	return f.GetFederationClientIDFunc(federationContextID)
+}
+
+func (f *FakeMetaStoreClient) GetGuestFederationClientID(ctx context.Context, federationCallbackID string) (string, error) {
+	return f.GetGuestFederationClientIDFunc(federationCallbackID)
+}
+
+func (f *FakeMetaStoreClient) CreateResourcePoolReservation(ctx context.Context, reservation *metastore.ResourcePoolReservation) error {
+	return f.CreateResourcePoolReservationFunc(reservation)
+}
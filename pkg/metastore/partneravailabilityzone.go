@@ -33,6 +33,43 @@ func mergeUnique(slice1, slice2 []string) []string {
 	return result
 }
 
+// removeAll returns slice1 without the elements present in slice2,
+// preserving the order of the remaining elements.
+func removeAll(slice1, slice2 []string) []string {
+	remove := make(map[string]bool, len(slice2))
+	for _, val := range slice2 {
+		remove[val] = true
+	}
+	result := []string{}
+	for _, val := range slice1 {
+		if !remove[val] {
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+// notIn returns the elements of slice1 that are not present in slice2.
+func notIn(slice1, slice2 []string) []string {
+	present := make(map[string]bool, len(slice2))
+	for _, val := range slice2 {
+		present[val] = true
+	}
+	result := []string{}
+	for _, val := range slice1 {
+		if !present[val] {
+			result = append(result, val)
+		}
+	}
+	return result
+}
+
+// CandidateZone is a zone where an instance of an application can be created.
+type CandidateZone struct {
+	ZoneId      models.ZoneIdentifier `json:"zoneId"`
+	Geolocation models.GeoLocation    `json:"geolocation"`
+}
+
 func partnerAvailabilityZoneFromK8sAvailabilityZone(az *opgv1beta1.AvailabilityZone) (*PartnerAvailabilityZone, error) {
 	return &PartnerAvailabilityZone{
 		ZoneDetails: &models.ZoneDetails{
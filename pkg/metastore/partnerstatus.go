@@ -0,0 +1,182 @@
+package metastore
+
+import (
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	opgv1beta1 "github.com/neonephos-katalis/opg-ewbi-operator/api/v1beta1"
+)
+
+// UpdatePartnerStatus is a change the partner notifies about the federation
+// we created as guest on it.
+type UpdatePartnerStatus struct {
+	*models.PartnerStatusLinkJSONRequestBody
+	FederationCallbackId string
+}
+
+// PartnerDetails are the partner endpoints and network codes notified for the
+// federation we created as guest. They are read from the federation on every
+// call, partnerclient.GuestLcmEndpoint relies on it to follow the endpoint updates.
+type PartnerDetails struct {
+	EdgeDiscoverySvcEndPoint *models.ServiceEndpoint                 `json:"edgeDiscoverySvcEndPoint,omitempty"`
+	LcmSvcEndPoint           *models.ServiceEndpoint                 `json:"lcmSvcEndPoint,omitempty"`
+	MobileNetworkCodes       opgv1beta1.MobileNetworkCodes           `json:"mobileNetworkCodes,omitempty"`
+	FixedNetworkCodes        []string                                `json:"fixedNetworkCodes,omitempty"`
+	ZoneStatus               map[models.ZoneIdentifier]models.Status `json:"-"`
+}
+
+// updatek8sCustomResource applies the partner changes to the guest federation.
+// Updates older than the last applied modification are rejected.
+func (u *UpdatePartnerStatus) updatek8sCustomResource(fed *opgv1beta1.Federation) error {
+	if err := checkModificationDate(fed, u.ModificationDate); err != nil {
+		return err
+	}
+
+	var err error
+	switch u.ObjectType {
+	case models.PartnerStatusLinkJSONBodyObjectTypeZONES:
+		err = u.updateZones(fed)
+	case models.PartnerStatusLinkJSONBodyObjectTypeEDGEDISCOVERYSERVICE,
+		models.PartnerStatusLinkJSONBodyObjectTypeLCMSERVICE,
+		models.PartnerStatusLinkJSONBodyObjectTypeMOBILENETWORKCODES,
+		models.PartnerStatusLinkJSONBodyObjectTypeFIXEDNETWORKCODES:
+		err = u.updatePartnerDetails(fed)
+	default:
+		err = errors.Wrapf(ErrBadRequest, "unsupported objectType '%s'", u.ObjectType)
+	}
+	if err != nil {
+		return err
+	}
+
+	setAnnotation(fed, modificationDateAnnotation, u.ModificationDate.Format(time.RFC3339Nano))
+	return nil
+}
+
+func (u *UpdatePartnerStatus) updateZones(fed *opgv1beta1.Federation) error {
+	statuses, err := getZoneStatuses(fed)
+	if err != nil {
+		return err
+	}
+
+	isOffered := func(zoneID string) bool {
+		return slices.ContainsFunc(fed.Status.OfferedAvailabilityZones, func(z opgv1beta1.ZoneDetails) bool { return z.ZoneId == zoneID })
+	}
+	switch u.OperationType {
+	case models.PartnerStatusLinkJSONBodyOperationTypeADD:
+		if u.AddZones == nil {
+			return errors.Wrap(ErrBadRequest, "missing addZones")
+		}
+		for _, z := range *u.AddZones {
+			zone := opgv1beta1.ZoneDetails{
+				ZoneId:           z.ZoneId,
+				Geolocation:      z.Geolocation,
+				GeographyDetails: z.GeographyDetails,
+			}
+			if i := slices.IndexFunc(fed.Status.OfferedAvailabilityZones, func(o opgv1beta1.ZoneDetails) bool { return o.ZoneId == z.ZoneId }); i >= 0 {
+				fed.Status.OfferedAvailabilityZones[i] = zone
+				continue
+			}
+			fed.Status.OfferedAvailabilityZones = append(fed.Status.OfferedAvailabilityZones, zone)
+		}
+	case models.PartnerStatusLinkJSONBodyOperationTypeREMOVE:
+		if u.RemoveZones == nil {
+			return errors.Wrap(ErrBadRequest, "missing removeZones")
+		}
+		for _, zoneID := range *u.RemoveZones {
+			if !isOffered(zoneID) {
+				return errors.Wrapf(ErrBadRequest, "zone '%s' is not offered in the federation", zoneID)
+			}
+			delete(statuses, zoneID)
+		}
+		fed.Status.OfferedAvailabilityZones = slices.DeleteFunc(fed.Status.OfferedAvailabilityZones, func(z opgv1beta1.ZoneDetails) bool {
+			return slices.Contains(*u.RemoveZones, z.ZoneId)
+		})
+	case models.PartnerStatusLinkJSONBodyOperationTypeSTATUS:
+		if u.ZoneStatus == nil {
+			return errors.Wrap(ErrBadRequest, "missing zoneStatus")
+		}
+		for _, z := range *u.ZoneStatus {
+			if !isOffered(z.ZoneId) {
+				return errors.Wrapf(ErrBadRequest, "zone '%s' is not offered in the federation", z.ZoneId)
+			}
+			if !isValidFederationStatus(string(z.Status)) {
+				return errors.Wrapf(ErrBadRequest, "invalid status '%s' for zone '%s'", z.Status, z.ZoneId)
+			}
+			statuses[z.ZoneId] = z.Status
+		}
+	default:
+		return errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+	}
+	return setAnnotationJSON(fed, zoneStatusAnnotation, statuses)
+}
+
+func (u *UpdatePartnerStatus) updatePartnerDetails(fed *opgv1beta1.Federation) error {
+	details, err := getPartnerDetails(fed)
+	if err != nil {
+		return err
+	}
+
+	switch u.ObjectType {
+	case models.PartnerStatusLinkJSONBodyObjectTypeEDGEDISCOVERYSERVICE:
+		if u.OperationType != models.PartnerStatusLinkJSONBodyOperationTypeUPDATE {
+			return errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+		}
+		if u.EdgeDiscoverySvcEndPoint == nil {
+			return errors.Wrap(ErrBadRequest, "missing edgeDiscoverySvcEndPoint")
+		}
+		details.EdgeDiscoverySvcEndPoint = u.EdgeDiscoverySvcEndPoint
+	case models.PartnerStatusLinkJSONBodyObjectTypeLCMSERVICE:
+		if u.OperationType != models.PartnerStatusLinkJSONBodyOperationTypeUPDATE {
+			return errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+		}
+		if u.LcmSvcEndPoint == nil {
+			return errors.Wrap(ErrBadRequest, "missing lcmSvcEndPoint")
+		}
+		details.LcmSvcEndPoint = u.LcmSvcEndPoint
+	default:
+		// network codes follow the same rules as the ones notified through UpdateFederation
+		codes := &UpdateFederation{UpdateFederationJSONBody: &models.UpdateFederationJSONBody{
+			AddMobileNetworkIds:    u.AddMobileNetworkIds,
+			RemoveMobileNetworkIds: u.RemoveMobileNetworkIds,
+			AddFixedNetworkIds:     u.AddFixedNetworkIds,
+			RemoveFixedNetworkIds:  u.RemoveFixedNetworkIds,
+		}}
+		switch u.OperationType {
+		case models.PartnerStatusLinkJSONBodyOperationTypeADD:
+			codes.OperationType = models.ADDCODES
+		case models.PartnerStatusLinkJSONBodyOperationTypeREMOVE:
+			codes.OperationType = models.REMOVECODES
+		default:
+			return errors.Wrapf(ErrBadRequest, "unsupported operationType '%s'", u.OperationType)
+		}
+		if u.ObjectType == models.PartnerStatusLinkJSONBodyObjectTypeMOBILENETWORKCODES {
+			err = codes.updateMobileNetworkCodes(&details.MobileNetworkCodes)
+		} else {
+			details.FixedNetworkCodes, err = codes.updateFixedNetworkCodes(details.FixedNetworkCodes)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return setAnnotationJSON(fed, partnerDetailsAnnotation, details)
+}
+
+func getPartnerDetails(fed *opgv1beta1.Federation) (*PartnerDetails, error) {
+	details := &PartnerDetails{}
+	if err := getAnnotationJSON(fed, partnerDetailsAnnotation, details); err != nil {
+		return nil, err
+	}
+	return details, nil
+}
+
+// getZoneStatuses returns the status the partner last notified for each of the offered zones.
+func getZoneStatuses(fed *opgv1beta1.Federation) (map[models.ZoneIdentifier]models.Status, error) {
+	statuses := map[models.ZoneIdentifier]models.Status{}
+	if err := getAnnotationJSON(fed, zoneStatusAnnotation, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
@@ -0,0 +1,166 @@
+package metastore
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+// ResourcePool is a set of compute flavours reserved by an ISV on a zone.
+type ResourcePool struct {
+	FederationContextId             models.FederationContextId         `json:"federationContextId"`
+	ZoneId                          models.ZoneIdentifier              `json:"zoneId"`
+	AppProviderId                   models.AppProviderId               `json:"appProviderId"`
+	PoolId                          models.PoolId                      `json:"poolId"`
+	PoolName                        models.PoolName                    `json:"poolName"`
+	Flavours                        []ResourcePoolFlavour              `json:"flavours"`
+	ReserveDuration                 models.ResourceReservationDuration `json:"reserveDuration"`
+	ReservationTime                 time.Time                          `json:"reservationTime"`
+	ResourceReservationCallbackLink models.Uri                         `json:"resourceReservationCallbackLink"`
+}
+
+type ResourcePoolFlavour struct {
+	FlavourId        models.FlavourId `json:"flavourId"`
+	NumFlavour       int32            `json:"numFlavour"`
+	MinNumOfFlavours *int32           `json:"minNumOfFlavours,omitempty"`
+}
+
+type CreateResourcePool struct {
+	*models.CreateResourcePoolsJSONBody
+	FederationContextId models.FederationContextId
+	ZoneId              models.ZoneIdentifier
+	AppProviderId       models.AppProviderId
+}
+
+func (p *CreateResourcePool) resourcePool() (*ResourcePool, error) {
+	req := p.ResRequest
+	pool := &ResourcePool{
+		FederationContextId:             p.FederationContextId,
+		ZoneId:                          p.ZoneId,
+		AppProviderId:                   p.AppProviderId,
+		PoolId:                          req.PoolId,
+		PoolName:                        req.PoolName,
+		Flavours:                        make([]ResourcePoolFlavour, 0, len(req.Flavours)),
+		ReserveDuration:                 req.ReserveDuration,
+		ReservationTime:                 time.Now().UTC(),
+		ResourceReservationCallbackLink: p.ResourceReservationCallbackLink,
+	}
+	for _, f := range req.Flavours {
+		if f.NumFlavour <= 0 {
+			return nil, errors.Wrapf(ErrBadRequest, "flavour '%s' must reserve at least one instance", f.FlavourId)
+		}
+		if f.MinNumOfFlavours != nil && (*f.MinNumOfFlavours < 0 || *f.MinNumOfFlavours > f.NumFlavour) {
+			return nil, errors.Wrapf(ErrBadRequest, "flavour '%s' minimum must be between 0 and %d", f.FlavourId, f.NumFlavour)
+		}
+		if pool.flavour(f.FlavourId) != nil {
+			return nil, errors.Wrapf(ErrBadRequest, "flavour '%s' is duplicated", f.FlavourId)
+		}
+		pool.Flavours = append(pool.Flavours, ResourcePoolFlavour{
+			FlavourId:        f.FlavourId,
+			NumFlavour:       f.NumFlavour,
+			MinNumOfFlavours: f.MinNumOfFlavours,
+		})
+	}
+	return pool, nil
+}
+
+func (p *ResourcePool) k8sCustomResource(namespace string, opts ...Opt) (*corev1.ConfigMap, error) {
+	return newK8sConfigMap(
+		k8sCustomResourceNameFromResourcePool(p.FederationContextId, p.ZoneId, p.AppProviderId, p.PoolId),
+		namespace,
+		map[string]string{
+			opgLabel(federationContextIDLabel): p.FederationContextId,
+			opgLabel(idLabel):                  p.PoolId,
+			opgLabel(federationRelation):       host,
+			opgLabel(kindLabel):                resourcePoolKind,
+			opgLabel(zoneIDLabel):              p.ZoneId,
+			opgLabel(appProviderIDLabel):       p.AppProviderId,
+		},
+		p,
+		opts...,
+	)
+}
+
+func (p *ResourcePool) flavour(id models.FlavourId) *ResourcePoolFlavour {
+	for i := range p.Flavours {
+		if p.Flavours[i].FlavourId == id {
+			return &p.Flavours[i]
+		}
+	}
+	return nil
+}
+
+type UpdateResourcePool struct {
+	models.UpdateISVResPoolJSONBody
+	FederationContextId models.FederationContextId
+	ZoneId              models.ZoneIdentifier
+	AppProviderId       models.AppProviderId
+	PoolId              models.PoolId
+}
+
+// apply updates the pool flavours. The count of ADD and REMOVE updates is the
+// final number of flavours reserved, a REMOVE with count 0 releases the flavour.
+func (u *UpdateResourcePool) apply(pool *ResourcePool) error {
+	for _, update := range u.UpdateISVResPoolJSONBody {
+		if update.Count < 0 {
+			return errors.Wrapf(ErrBadRequest, "flavour '%s' count must not be negative", update.FlavourId)
+		}
+		current := pool.flavour(update.FlavourId)
+		switch update.UpdateType {
+		case models.UpdateISVResPoolJSONBodyUpdateTypeADD:
+			switch {
+			case current == nil && update.Count > 0:
+				pool.Flavours = append(pool.Flavours, ResourcePoolFlavour{FlavourId: update.FlavourId, NumFlavour: update.Count})
+			case current == nil || update.Count < current.NumFlavour:
+				return errors.Wrapf(ErrBadRequest, "adding flavour '%s' cannot reduce its count", update.FlavourId)
+			default:
+				current.NumFlavour = update.Count
+			}
+		case models.UpdateISVResPoolJSONBodyUpdateTypeREMOVE:
+			switch {
+			case current == nil:
+				return errors.Wrapf(ErrBadRequest, "flavour '%s' is not reserved in pool '%s'", update.FlavourId, pool.PoolId)
+			case update.Count > current.NumFlavour:
+				return errors.Wrapf(ErrBadRequest, "removing flavour '%s' cannot increase its count", update.FlavourId)
+			case update.Count == 0:
+				pool.Flavours = slices.DeleteFunc(pool.Flavours, func(f ResourcePoolFlavour) bool { return f.FlavourId == update.FlavourId })
+				current = nil
+			default:
+				current.NumFlavour = update.Count
+			}
+		case models.UpdateISVResPoolJSONBodyUpdateTypeDURATION:
+			if update.ReserveDuration == nil {
+				return errors.Wrapf(ErrBadRequest, "reserveDuration is required to update the duration of pool '%s'", pool.PoolId)
+			}
+			pool.ReserveDuration = *update.ReserveDuration
+			pool.ReservationTime = time.Now().UTC()
+		default:
+			return errors.Wrapf(ErrBadRequest, "unknown update type '%s'", update.UpdateType)
+		}
+		if current != nil && current.MinNumOfFlavours != nil && *current.MinNumOfFlavours > current.NumFlavour {
+			minNumOfFlavours := current.NumFlavour
+			current.MinNumOfFlavours = &minNumOfFlavours
+		}
+	}
+	if len(pool.Flavours) == 0 {
+		return errors.Wrapf(ErrBadRequest, "pool '%s' must keep at least one flavour, remove the pool instead", pool.PoolId)
+	}
+	return nil
+}
+
+func resourcePoolFromK8sCustomResource(obj *corev1.ConfigMap) (*ResourcePool, error) {
+	pool := &ResourcePool{}
+	if err := getConfigMapJSON(obj, pool); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func k8sCustomResourceNameFromResourcePool(federationContextID, zoneID, appProviderID, poolID string) string {
+	return fmt.Sprintf("%s-%s", resourcePoolPrefix, uuidV5Fn(federationContextID+"/"+zoneID+"/"+appProviderID+"/"+poolID))
+}
@@ -0,0 +1,117 @@
+package metastore
+
+import (
+	"testing"
+
+	"github.com/icza/gog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func Test_UpdateResourcePool_apply(t *testing.T) {
+	newPool := func() *ResourcePool {
+		return &ResourcePool{
+			PoolId: "pool",
+			Flavours: []ResourcePoolFlavour{
+				{FlavourId: "small", NumFlavour: 4, MinNumOfFlavours: gog.Ptr[int32](2)},
+				{FlavourId: "large", NumFlavour: 1},
+			},
+		}
+	}
+	update := func(updateType models.UpdateISVResPoolJSONBodyUpdateType, flavourID string, count int32) *UpdateResourcePool {
+		return &UpdateResourcePool{UpdateISVResPoolJSONBody: models.UpdateISVResPoolJSONBody{{
+			UpdateType: updateType,
+			FlavourId:  flavourID,
+			Count:      count,
+		}}}
+	}
+
+	t.Run("Add sets the final count", func(t *testing.T) {
+		pool := newPool()
+		require.NoError(t, update(models.UpdateISVResPoolJSONBodyUpdateTypeADD, "large", 3).apply(pool))
+		require.NoError(t, update(models.UpdateISVResPoolJSONBodyUpdateTypeADD, "gpu", 2).apply(pool))
+		require.Equal(t, int32(3), pool.flavour("large").NumFlavour)
+		require.Equal(t, int32(2), pool.flavour("gpu").NumFlavour)
+	})
+
+	t.Run("Add cannot reduce the count", func(t *testing.T) {
+		err := update(models.UpdateISVResPoolJSONBodyUpdateTypeADD, "small", 1).apply(newPool())
+		require.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("Remove lowers the count and the minimum", func(t *testing.T) {
+		pool := newPool()
+		require.NoError(t, update(models.UpdateISVResPoolJSONBodyUpdateTypeREMOVE, "small", 1).apply(pool))
+		require.Equal(t, int32(1), pool.flavour("small").NumFlavour)
+		require.Equal(t, int32(1), *pool.flavour("small").MinNumOfFlavours)
+	})
+
+	t.Run("Remove with count 0 releases the flavour", func(t *testing.T) {
+		pool := newPool()
+		require.NoError(t, update(models.UpdateISVResPoolJSONBodyUpdateTypeREMOVE, "small", 0).apply(pool))
+		require.Nil(t, pool.flavour("small"))
+		require.Equal(t, int32(1), pool.flavour("large").NumFlavour)
+	})
+
+	t.Run("Pool cannot be left empty", func(t *testing.T) {
+		pool := &ResourcePool{Flavours: []ResourcePoolFlavour{{FlavourId: "small", NumFlavour: 1}}}
+		err := update(models.UpdateISVResPoolJSONBodyUpdateTypeREMOVE, "small", 0).apply(pool)
+		require.ErrorIs(t, err, ErrBadRequest)
+	})
+
+	t.Run("Duration requires reserveDuration", func(t *testing.T) {
+		err := update(models.UpdateISVResPoolJSONBodyUpdateTypeDURATION, "small", 4).apply(newPool())
+		require.ErrorIs(t, err, ErrBadRequest)
+	})
+}
+
+func Test_ResourcePoolReservation_State(t *testing.T) {
+	newReservation := func() *ResourcePoolReservation {
+		r := &ResourcePoolReservation{PoolId: "pool", ZoneId: "zone"}
+		r.request([]ResourcePoolFlavour{
+			{FlavourId: "small", NumFlavour: 4},
+			{FlavourId: "large", NumFlavour: 1},
+		})
+		return r
+	}
+	grant := func(small, large int32) *models.ResourceReservationCallbackLinkJSONRequestBody {
+		g := &models.ResourceReservationCallbackLinkJSONRequestBody{PoolId: "pool", ZoneId: "zone"}
+		g.GrantedFlavours = append(g.GrantedFlavours, struct {
+			FlavourId  models.FlavourId `json:"flavourId"`
+			NumFlavour int32            `json:"numFlavour"`
+		}{FlavourId: "small", NumFlavour: small})
+		if large > 0 {
+			g.GrantedFlavours = append(g.GrantedFlavours, struct {
+				FlavourId  models.FlavourId `json:"flavourId"`
+				NumFlavour int32            `json:"numFlavour"`
+			}{FlavourId: "large", NumFlavour: large})
+		}
+		return g
+	}
+
+	t.Run("Pending until granted", func(t *testing.T) {
+		require.Equal(t, ResourcePoolReservationPending, newReservation().State())
+	})
+
+	t.Run("Fully granted", func(t *testing.T) {
+		r := newReservation()
+		r.grant(grant(4, 1))
+		require.Equal(t, ResourcePoolReservationGranted, r.State())
+	})
+
+	t.Run("Partially granted", func(t *testing.T) {
+		r := newReservation()
+		r.grant(grant(2, 0))
+		require.Equal(t, ResourcePoolReservationPartiallyGranted, r.State())
+		require.Equal(t, []ResourcePoolReservationFlavour{
+			{FlavourId: "small", Requested: 4, Granted: 2},
+			{FlavourId: "large", Requested: 1, Granted: 0},
+		}, r.Flavours())
+	})
+	t.Run("Granted without request", func(t *testing.T) {
+		r := &ResourcePoolReservation{PoolId: "pool", ZoneId: "zone"}
+		r.grant(grant(4, 1))
+		require.Equal(t, ResourcePoolReservationUnrequested, r.State())
+	})
+}
@@ -0,0 +1,121 @@
+package metastore
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+type ResourcePoolReservationState string
+
+const (
+	ResourcePoolReservationPending          ResourcePoolReservationState = "PENDING"
+	ResourcePoolReservationGranted          ResourcePoolReservationState = "GRANTED"
+	ResourcePoolReservationPartiallyGranted ResourcePoolReservationState = "PARTIALLY_GRANTED"
+	ResourcePoolReservationUnrequested      ResourcePoolReservationState = "UNREQUESTED"
+)
+
+// ResourcePoolReservation is a pool we asked a partner to reserve as guest,
+// along with the flavours the partner granted through the reservation callback.
+// RequestTime is set when the request is recorded, grants received for pools
+// with no recorded request are kept without it.
+type ResourcePoolReservation struct {
+	FederationCallbackId string                `json:"federationCallbackId"`
+	ZoneId               models.ZoneIdentifier `json:"zoneId"`
+	AppProviderId        models.AppProviderId  `json:"appProviderId"`
+	PoolId               models.PoolId         `json:"poolId"`
+	RequestedFlavours    []ResourcePoolFlavour `json:"requestedFlavours,omitempty"`
+	RequestTime          *time.Time            `json:"requestTime,omitempty"`
+	GrantedFlavours      []ResourcePoolFlavour `json:"grantedFlavours,omitempty"`
+	GrantTime            *time.Time            `json:"grantTime,omitempty"`
+}
+
+type ResourcePoolReservationFlavour struct {
+	FlavourId models.FlavourId `json:"flavourId"`
+	Requested int32            `json:"requested"`
+	Granted   int32            `json:"granted"`
+}
+
+// Flavours returns the requested and granted count of every flavour in the
+// reservation. Flavours granted without a recorded request have no requested count.
+func (r *ResourcePoolReservation) Flavours() []ResourcePoolReservationFlavour {
+	flavours := make([]ResourcePoolReservationFlavour, 0, len(r.RequestedFlavours))
+	index := map[models.FlavourId]int{}
+	for _, f := range r.RequestedFlavours {
+		index[f.FlavourId] = len(flavours)
+		flavours = append(flavours, ResourcePoolReservationFlavour{FlavourId: f.FlavourId, Requested: f.NumFlavour})
+	}
+	for _, f := range r.GrantedFlavours {
+		i, ok := index[f.FlavourId]
+		if !ok {
+			i = len(flavours)
+			index[f.FlavourId] = i
+			flavours = append(flavours, ResourcePoolReservationFlavour{FlavourId: f.FlavourId})
+		}
+		flavours[i].Granted += f.NumFlavour
+	}
+	return flavours
+}
+
+// State reports whether the partner granted every requested flavour.
+func (r *ResourcePoolReservation) State() ResourcePoolReservationState {
+	if r.GrantTime == nil {
+		return ResourcePoolReservationPending
+	}
+	if r.RequestTime == nil {
+		return ResourcePoolReservationUnrequested
+	}
+	for _, f := range r.Flavours() {
+		if f.Granted < f.Requested {
+			return ResourcePoolReservationPartiallyGranted
+		}
+	}
+	return ResourcePoolReservationGranted
+}
+
+func (r *ResourcePoolReservation) request(requestedFlavours []ResourcePoolFlavour) {
+	r.RequestedFlavours = requestedFlavours
+	requestTime := time.Now().UTC()
+	r.RequestTime = &requestTime
+}
+
+func (r *ResourcePoolReservation) grant(grant *models.ResourceReservationCallbackLinkJSONRequestBody) {
+	r.ZoneId = grant.ZoneId
+	r.AppProviderId = grant.AppProviderId
+	r.GrantedFlavours = make([]ResourcePoolFlavour, 0, len(grant.GrantedFlavours))
+	for _, f := range grant.GrantedFlavours {
+		r.GrantedFlavours = append(r.GrantedFlavours, ResourcePoolFlavour{FlavourId: f.FlavourId, NumFlavour: f.NumFlavour})
+	}
+	grantTime := time.Now().UTC()
+	r.GrantTime = &grantTime
+}
+
+func (r *ResourcePoolReservation) k8sCustomResource(namespace string, opts ...Opt) (*corev1.ConfigMap, error) {
+	return newK8sConfigMap(
+		k8sCustomResourceNameFromResourcePoolReservation(r.FederationCallbackId, r.PoolId),
+		namespace,
+		map[string]string{
+			opgLabel(federationCallbackIDLabel): r.FederationCallbackId,
+			opgLabel(idLabel):                   r.PoolId,
+			opgLabel(federationRelation):        guest,
+			opgLabel(kindLabel):                 resourcePoolReservationKind,
+		},
+		r,
+		opts...,
+	)
+}
+
+func resourcePoolReservationFromK8sCustomResource(obj *corev1.ConfigMap) (*ResourcePoolReservation, error) {
+	r := &ResourcePoolReservation{}
+	if err := getConfigMapJSON(obj, r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func k8sCustomResourceNameFromResourcePoolReservation(federationCallbackID, poolID string) string {
+	return fmt.Sprintf("%s-%s", resourcePoolReservationPrefix, uuidV5Fn(federationCallbackID+"/"+poolID))
+}
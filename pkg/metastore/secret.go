@@ -0,0 +1,105 @@
+package metastore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8scli "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Credentials sent by the partner OPs are not kept in the custom resource specs,
+// readable by anyone allowed to read them, but in a Secret owned by the custom
+// resource and referenced by its credentials-secret annotation.
+
+const (
+	secretAccessTokenKey  string = "accessToken"
+	secretClientSecretKey string = "clientSecret"
+	secretPasswordKey     string = "password"
+	secretTokenKey        string = "token"
+)
+
+func credentialsSecretName(owner metav1.Object) string {
+	return owner.GetName() + "-credentials"
+}
+
+// setCredentialsSecretRef references the credentials Secret from the custom resource
+// when there are credentials to keep. It must be set before the resource is saved.
+func setCredentialsSecretRef(owner metav1.Object, credentials map[string]string) {
+	for _, v := range credentials {
+		if v != "" {
+			setAnnotation(owner, credentialsSecretAnnotation, credentialsSecretName(owner))
+			return
+		}
+	}
+}
+
+// createK8sObjectWithCredentials creates the custom resource and the Secret keeping
+// its credentials. The resource is removed if the Secret cannot be saved.
+func (c *k8sClient) createK8sObjectWithCredentials(obj k8scli.Object, credentials map[string]string) error {
+	setCredentialsSecretRef(obj, credentials)
+	if err := c.createK8sObject(obj); err != nil {
+		return err
+	}
+	if err := c.saveCredentials(obj, credentials); err != nil {
+		if delErr := c.kubernetes.Delete(context.TODO(), obj); delErr != nil {
+			log.WithError(delErr).Errorf("failed to remove %s '%s' without credentials", getObjectKind(obj), obj.GetName())
+		}
+		return err
+	}
+	return nil
+}
+
+// saveCredentials stores the non empty credentials in the Secret referenced by the custom resource.
+func (c *k8sClient) saveCredentials(owner k8scli.Object, credentials map[string]string) error {
+	name := getAnnotation(owner, credentialsSecretAnnotation)
+	if name == "" {
+		return nil
+	}
+	data := map[string][]byte{}
+	for k, v := range credentials {
+		if v != "" {
+			data[k] = []byte(v)
+		}
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+			Labels: map[string]string{
+				opgLabel(idLabel):   getObjectID(owner),
+				opgLabel(kindLabel): credentialsKind,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+	if err := WithOwnerReference(owner, c.getScheme())(secret); err != nil {
+		return err
+	}
+	err := c.createK8sObject(secret)
+	if errors.Is(err, ErrAlreadyExists) {
+		return c.updateK8sObject(secret)
+	}
+	return err
+}
+
+// getCredentials returns the credentials kept for the custom resource, if any.
+func (c *k8sClient) getCredentials(owner metav1.Object) (map[string][]byte, error) {
+	name := getAnnotation(owner, credentialsSecretAnnotation)
+	if name == "" {
+		return map[string][]byte{}, nil
+	}
+	secret := &corev1.Secret{}
+	if err := c.kubernetes.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: owner.GetNamespace()}, secret); err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, errors.Wrapf(ErrInternal, "credentials secret '%s' not found", name)
+		}
+		return nil, errors.Wrapf(ErrInternal, "failed to get credentials secret '%s': %s", name, err.Error())
+	}
+	return secret.Data, nil
+}
@@ -0,0 +1,45 @@
+package metastore
+
+import (
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+// ZoneResources is the latest resource availability a partner notified for
+// one of the zones it offers to us.
+type ZoneResources struct {
+	models.AvailZoneNotifLinkJSONBody
+	FederationCallbackId string    `json:"federationCallbackId"`
+	UpdateTime           time.Time `json:"updateTime"`
+}
+
+func (z *ZoneResources) k8sCustomResource(namespace string, opts ...Opt) (*corev1.ConfigMap, error) {
+	return newK8sConfigMap(
+		k8sCustomResourceNameFromZoneResources(z.FederationCallbackId, z.ZoneId),
+		namespace,
+		map[string]string{
+			opgLabel(federationCallbackIDLabel): z.FederationCallbackId,
+			opgLabel(federationRelation):        guest,
+			opgLabel(kindLabel):                 zoneResourcesKind,
+			opgLabel(zoneIDLabel):               z.ZoneId,
+		},
+		z,
+		opts...,
+	)
+}
+
+func zoneResourcesFromK8sCustomResource(obj *corev1.ConfigMap) (*ZoneResources, error) {
+	res := &ZoneResources{}
+	if err := getConfigMapJSON(obj, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func k8sCustomResourceNameFromZoneResources(federationCallbackID, zoneID string) string {
+	return fmt.Sprintf("%s-%s", zoneResourcesPrefix, uuidV5Fn(federationCallbackID+"/"+zoneID))
+}
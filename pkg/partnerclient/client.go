@@ -0,0 +1,133 @@
+// Package partnerclient calls the EWBI of a partner OP, authenticating with
+// the OAuth2 client credentials the partner OP provided.
+package partnerclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/client"
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+const (
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultBackoff    = 500 * time.Millisecond
+)
+
+// Config configures the client of a partner OP. Server is the root of its
+// EWBI and Credentials the client credentials used to get the access tokens.
+// HTTPClient, typically holding the outbound TLS transport, is used for the
+// calls and the token requests. Idempotent calls are retried up to MaxRetries
+// times, waiting Backoff, doubled at each retry, between them.
+// When LcmEndpoint is set, it is called before each application onboarding
+// and deployment call, and the host and port of Server are replaced by the
+// endpoint it returns, so the LCM endpoint updates the partner OP notifies
+// are followed. The other calls are always sent to Server.
+// When RecordReservation is set, the resource pools the partner OP accepted
+// to reserve are recorded with it.
+type Config struct {
+	Server            string
+	LcmEndpoint       EndpointFunc
+	RecordReservation ReservationFunc
+	Credentials       models.CallbackCredentials
+	Scopes            []string
+	HTTPClient        *http.Client
+	MaxRetries        int
+	Backoff           time.Duration
+}
+
+// Client calls the operations of the EWBI of a partner OP.
+type Client struct {
+	api               *client.ClientWithResponses
+	lcm               []client.RequestEditorFn
+	recordReservation ReservationFunc
+}
+
+func New(config Config) (*Client, error) {
+	api, err := client.NewClientWithResponses(config.Server, client.WithHTTPClient(NewHTTPClient(config)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid partner OP server '%s'", config.Server)
+	}
+	c := &Client{api: api, recordReservation: config.RecordReservation}
+	if config.LcmEndpoint != nil {
+		c.lcm = append(c.lcm, withEndpoint(config.LcmEndpoint))
+	}
+	return c, nil
+}
+
+// NewHTTPClient returns the HTTP client authenticating the requests with the
+// access tokens of config.Credentials, for the calls to the partner OP outside
+// of its EWBI, such as the callback links. Server, LcmEndpoint and
+// RecordReservation are not used.
+func NewHTTPClient(config Config) *http.Client {
+	httpClient := config.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	base := httpClient.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if config.MaxRetries == 0 {
+		config.MaxRetries = defaultMaxRetries
+	}
+	if config.Backoff == 0 {
+		config.Backoff = defaultBackoff
+	}
+
+	credentials := &clientcredentials.Config{
+		ClientID:     config.Credentials.ClientId,
+		ClientSecret: config.Credentials.ClientSecret,
+		TokenURL:     config.Credentials.TokenUrl,
+		Scopes:       config.Scopes,
+	}
+	tokenContext := context.WithValue(context.Background(), oauth2.HTTPClient, httpClient)
+	return &http.Client{
+		Transport: &transport{
+			base:       base,
+			tokens:     newTokenSource(tokenContext, credentials),
+			maxRetries: config.MaxRetries,
+			backoff:    config.Backoff,
+		},
+		Timeout: httpClient.Timeout,
+	}
+}
+
+// Error is returned when the partner OP answers with an error status.
+// Problem holds the details it sent, if any.
+type Error struct {
+	StatusCode int
+	Problem    *models.ProblemDetails
+}
+
+func (e *Error) Error() string {
+	if e.Problem != nil && e.Problem.Detail != nil {
+		return fmt.Sprintf("partner OP returned status %d: %s", e.StatusCode, *e.Problem.Detail)
+	}
+	if e.Problem != nil && e.Problem.Title != nil {
+		return fmt.Sprintf("partner OP returned status %d: %s", e.StatusCode, *e.Problem.Title)
+	}
+	return fmt.Sprintf("partner OP returned status %d", e.StatusCode)
+}
+
+// checkResponse returns an *Error when the response has an error status.
+func checkResponse(res *http.Response, body []byte) error {
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+	e := &Error{StatusCode: res.StatusCode}
+	problem := &models.ProblemDetails{}
+	if err := json.Unmarshal(body, problem); err == nil {
+		e.Problem = problem
+	}
+	return e
+}
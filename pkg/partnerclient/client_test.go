@@ -0,0 +1,190 @@
+package partnerclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func Test_Client(t *testing.T) {
+	var tokens, calls int
+	var unavailable, expired bool
+	var uploaded *models.UploadFileMultipartBody
+	var uploadedFile string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /oauth2/token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+		tokens++
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": fmt.Sprintf("token-%d", tokens),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("/{federationContextId}/partner", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		switch {
+		case expired:
+			expired = false
+			w.WriteHeader(http.StatusUnauthorized)
+		case unavailable:
+			unavailable = false
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.PathValue("federationContextId") == "unknown":
+			w.Header().Set("Content-Type", "application/problem+json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"federation not found"}`))
+		default:
+			require.Equal(t, fmt.Sprintf("Bearer token-%d", tokens), r.Header.Get("Authorization"))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"edgeDiscoveryServiceEndPoint":{"port":8443},"lcmServiceEndPoint":{"port":8443}}`))
+		}
+	})
+	mux.HandleFunc("POST /{federationContextId}/files", func(w http.ResponseWriter, r *http.Request) {
+		c := echo.New().NewContext(r, httptest.NewRecorder())
+		body, err := models.NewUploadFileMultipartBody(c)
+		require.NoError(t, err)
+		uploaded = body
+		_, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		uploadedFile = header.Filename
+		w.WriteHeader(http.StatusOK)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := New(Config{
+		Server:      server.URL,
+		Credentials: models.CallbackCredentials{ClientId: "client", ClientSecret: "secret", TokenUrl: server.URL + "/oauth2/token"},
+		Backoff:     1,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("Token is cached", func(t *testing.T) {
+		for range 2 {
+			res, err := c.GetFederationDetails(ctx, "fed")
+			require.NoError(t, err)
+			require.Equal(t, 8443, res.JSON200.LcmServiceEndPoint.Port)
+		}
+		require.Equal(t, 1, tokens)
+	})
+
+	t.Run("Idempotent calls are retried", func(t *testing.T) {
+		calls, unavailable = 0, true
+		_, err := c.GetFederationDetails(ctx, "fed")
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+	})
+
+	t.Run("Rejected token is refreshed", func(t *testing.T) {
+		calls, expired = 0, true
+		_, err := c.GetFederationDetails(ctx, "fed")
+		require.NoError(t, err)
+		require.Equal(t, 2, calls)
+		require.Equal(t, 2, tokens)
+	})
+
+	t.Run("Problem details are decoded", func(t *testing.T) {
+		_, err := c.GetFederationDetails(ctx, "unknown")
+		var e *Error
+		require.True(t, errors.As(err, &e))
+		require.Equal(t, http.StatusNotFound, e.StatusCode)
+		require.Equal(t, "federation not found", *e.Problem.Detail)
+	})
+
+	t.Run("Upload file", func(t *testing.T) {
+		repoType := models.UploadFileMultipartBodyRepoTypePUBLICREPO
+		repoURL := "https://repo.example.com/image"
+		file := models.UploadFileMultipartBody{
+			AppProviderId:   "provider",
+			FileId:          "file",
+			FileName:        "image",
+			FileType:        models.QCOW2,
+			FileVersionInfo: "1.0",
+			ImgInsSetArch:   models.CPUArchTypeISAX8664,
+			ImgOSType: models.OSType{
+				Architecture: models.X8664,
+				Distribution: models.OSTypeDistributionUBUNTU,
+				License:      models.OSLICENSETYPEFREE,
+				Version:      models.OSTypeVersionOSVERSIONUBUNTU2204LTS,
+			},
+			FileRepoLocation: &models.ObjectRepoLocation{RepoURL: &repoURL},
+			RepoType:         &repoType,
+		}
+		file.File = &openapi_types.File{}
+		file.File.InitFromBytes([]byte("content"), "image.qcow2")
+
+		_, err := c.UploadFile(ctx, "fed", file)
+		require.NoError(t, err)
+		require.Equal(t, "image.qcow2", uploadedFile)
+		uploaded.File = nil
+		file.File = nil
+		require.Equal(t, file, *uploaded)
+	})
+}
+
+func Test_Client_LcmEndpoint(t *testing.T) {
+	var calls []string
+	newServer := func(name string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/oauth2/token" {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+				return
+			}
+			calls = append(calls, name+" "+r.URL.Path)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{}`))
+		}))
+	}
+	configured, lcm := newServer("configured"), newServer("lcm")
+	defer configured.Close()
+	defer lcm.Close()
+
+	var endpoint *models.ServiceEndpoint
+	c, err := New(Config{
+		Server: configured.URL + "/ewbi/",
+		LcmEndpoint: func(ctx context.Context) (*models.ServiceEndpoint, error) {
+			return endpoint, nil
+		},
+		Credentials: models.CallbackCredentials{ClientId: "client", ClientSecret: "secret", TokenUrl: configured.URL + "/oauth2/token"},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = c.ViewApplication(ctx, "fed", "app")
+	require.NoError(t, err)
+
+	u, err := url.Parse(lcm.URL)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(u.Port())
+	require.NoError(t, err)
+	endpoint = &models.ServiceEndpoint{Ipv4Addresses: &[]models.Ipv4Addr{u.Hostname()}, Port: port}
+
+	_, err = c.ViewApplication(ctx, "fed", "app")
+	require.NoError(t, err)
+	_, err = c.GetFederationDetails(ctx, "fed")
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"configured /ewbi/fed/application/onboarding/app/app",
+		"lcm /ewbi/fed/application/onboarding/app/app",
+		"configured /ewbi/fed/partner",
+	}, calls)
+}
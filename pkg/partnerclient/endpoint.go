@@ -0,0 +1,67 @@
+package partnerclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/client"
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+)
+
+// EndpointFunc returns the current endpoint of the EWBI of a partner OP, nil
+// when the partner OP did not notify any.
+type EndpointFunc func(ctx context.Context) (*models.ServiceEndpoint, error)
+
+// GuestLcmEndpoint returns the LCM service endpoint the partner OP last notified
+// for the federation we created as guest on it, to be used as Config.LcmEndpoint.
+// It is read from the store on each call, so the updates are followed without restarting.
+func GuestLcmEndpoint(store metastore.Client, federationCallbackID string) EndpointFunc {
+	return func(ctx context.Context) (*models.ServiceEndpoint, error) {
+		details, err := store.GetPartnerDetails(ctx, federationCallbackID)
+		if err != nil {
+			return nil, err
+		}
+		return details.LcmSvcEndPoint, nil
+	}
+}
+
+// withEndpoint sends the requests to the host and port of the endpoint, when
+// there is one, keeping the scheme and the path of the configured server.
+func withEndpoint(endpoint EndpointFunc) client.RequestEditorFn {
+	return func(ctx context.Context, req *http.Request) error {
+		e, err := endpoint(ctx)
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve partner OP endpoint")
+		}
+		if e == nil {
+			return nil
+		}
+		host, err := endpointHost(e)
+		if err != nil {
+			return err
+		}
+		req.URL.Host, req.Host = host, host
+		return nil
+	}
+}
+
+func endpointHost(e *models.ServiceEndpoint) (string, error) {
+	port := strconv.Itoa(e.Port)
+	switch {
+	case e.Fqdn != nil && *e.Fqdn != "":
+		return net.JoinHostPort(*e.Fqdn, port), nil
+	case e.Ipv4Addresses != nil && len(*e.Ipv4Addresses) > 0:
+		return net.JoinHostPort((*e.Ipv4Addresses)[0], port), nil
+	case e.Ipv6Addresses != nil && len(*e.Ipv6Addresses) > 0:
+		// the generated Ipv6Addr is untyped, addresses are decoded as strings
+		if addr, ok := (*e.Ipv6Addresses)[0].(string); ok {
+			return net.JoinHostPort(addr, port), nil
+		}
+	}
+	return "", errors.New("partner OP endpoint has no address")
+}
@@ -0,0 +1,35 @@
+package partnerclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore/mock"
+)
+
+func Test_GuestLcmEndpoint(t *testing.T) {
+	fqdn := "lcm.partner.example.com"
+	details := &metastore.PartnerDetails{}
+	endpoint := GuestLcmEndpoint(&mock.FakeMetaStoreClient{
+		GetPartnerDetailsFunc: func(federationCallbackID string) (*metastore.PartnerDetails, error) {
+			require.Equal(t, "callback", federationCallbackID)
+			return details, nil
+		},
+	}, "callback")
+
+	e, err := endpoint(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, e)
+
+	// the partner notified an LCM_SERVICE update
+	details.LcmSvcEndPoint = &models.ServiceEndpoint{Fqdn: &fqdn, Port: 8443}
+	e, err = endpoint(context.Background())
+	require.NoError(t, err)
+	host, err := endpointHost(e)
+	require.NoError(t, err)
+	require.Equal(t, "lcm.partner.example.com:8443", host)
+}
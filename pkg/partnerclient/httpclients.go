@@ -0,0 +1,57 @@
+package partnerclient
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+// HTTPClients keeps the HTTP clients authenticating with the credentials of
+// each partner OP, so that their access tokens are reused between the calls.
+type HTTPClients struct {
+	httpClient *http.Client
+
+	mu      sync.Mutex
+	clients map[partnerKey]*partnerHTTPClient
+}
+
+type partnerKey struct {
+	clientID string
+	tokenURL string
+}
+
+type partnerHTTPClient struct {
+	credentials models.CallbackCredentials
+	httpClient  *http.Client
+}
+
+// NewHTTPClients returns the clients of the partner OPs, sending the calls
+// and the token requests with httpClient.
+func NewHTTPClients(httpClient *http.Client) *HTTPClients {
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: defaultTimeout}
+	}
+	return &HTTPClients{httpClient: httpClient, clients: map[partnerKey]*partnerHTTPClient{}}
+}
+
+// Get returns the HTTP client authenticating with the credentials, the plain
+// one when there are none. A single client is kept by client id and token
+// URL, replaced when the secret changes.
+func (h *HTTPClients) Get(credentials *models.CallbackCredentials) *http.Client {
+	if credentials == nil || credentials.TokenUrl == "" {
+		return h.httpClient
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	key := partnerKey{clientID: credentials.ClientId, tokenURL: credentials.TokenUrl}
+	if p, ok := h.clients[key]; ok && p.credentials == *credentials {
+		return p.httpClient
+	}
+	p := &partnerHTTPClient{
+		credentials: *credentials,
+		httpClient:  NewHTTPClient(Config{Credentials: *credentials, HTTPClient: h.httpClient}),
+	}
+	h.clients[key] = p
+	return p.httpClient
+}
@@ -0,0 +1,99 @@
+package partnerclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"sort"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/client"
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+func (c *Client) UploadArtefact(ctx context.Context, federationContextId models.FederationContextId, body models.UploadArtefactMultipartBody) (*client.UploadArtefactResponse, error) {
+	file := body.ArtefactFile
+	body.ArtefactFile = nil
+	payload, contentType, err := multipartBody(body, "artefactFile", file)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.api.UploadArtefactWithBodyWithResponse(ctx, federationContextId, contentType, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) UploadFile(ctx context.Context, federationContextId models.FederationContextId, body models.UploadFileMultipartBody) (*client.UploadFileResponse, error) {
+	file := body.File
+	body.File = nil
+	payload, contentType, err := multipartBody(body, "file", file)
+	if err != nil {
+		return nil, err
+	}
+	res, err := c.api.UploadFileWithBodyWithResponse(ctx, federationContextId, contentType, payload)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// multipartBody encodes each field of body as a form field, strings as they
+// are and the other values as JSON, the way models.NewUploadFileMultipartBody
+// and models.NewUploadArtefactMultipartBody decode them. The file, if any, is
+// added as the fileField part.
+func multipartBody(body any, fileField string, file *openapi_types.File) (*bytes.Buffer, string, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return nil, "", errors.Wrap(err, "failed to marshal multipart body")
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, "", errors.Wrap(err, "failed to marshal multipart body")
+	}
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	payload := &bytes.Buffer{}
+	w := multipart.NewWriter(payload)
+	for _, name := range names {
+		value := string(fields[name])
+		var s string
+		if json.Unmarshal(fields[name], &s) == nil {
+			value = s
+		}
+		if err := w.WriteField(name, value); err != nil {
+			return nil, "", errors.Wrap(err, "failed to write multipart body")
+		}
+	}
+	if file != nil {
+		content, err := file.Bytes()
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to read upload file")
+		}
+		part, err := w.CreateFormFile(fileField, file.Filename())
+		if err != nil {
+			return nil, "", errors.Wrap(err, "failed to write multipart body")
+		}
+		if _, err := part.Write(content); err != nil {
+			return nil, "", errors.Wrap(err, "failed to write multipart body")
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", errors.Wrap(err, "failed to write multipart body")
+	}
+	return payload, w.FormDataContentType(), nil
+}
@@ -0,0 +1,407 @@
+package partnerclient
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/client"
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+)
+
+// The operations of the partner OP EWBI. They return the generated response,
+// holding the decoded payload, or an *Error when the partner OP answers with
+// an error status. The application onboarding and deployment operations are
+// sent to the LCM endpoint of the partner OP, when it has one.
+
+func (c *Client) CreateFederation(ctx context.Context, body models.CreateFederationJSONRequestBody) (*client.CreateFederationResponse, error) {
+	res, err := c.api.CreateFederationWithResponse(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) AppInstCallbackLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.AppInstCallbackLinkJSONRequestBody) (*client.AppInstCallbackLinkResponse, error) {
+	res, err := c.api.AppInstCallbackLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) AppStatusCallbackLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.AppStatusCallbackLinkJSONRequestBody) (*client.AppStatusCallbackLinkResponse, error) {
+	res, err := c.api.AppStatusCallbackLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ArtefactStatusCallbackLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.ArtefactStatusCallbackLinkJSONRequestBody) (*client.ArtefactStatusCallbackLinkResponse, error) {
+	res, err := c.api.ArtefactStatusCallbackLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) AvailZoneNotifLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.AvailZoneNotifLinkJSONRequestBody) (*client.AvailZoneNotifLinkResponse, error) {
+	res, err := c.api.AvailZoneNotifLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) FileStatusCallbackLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.FileStatusCallbackLinkJSONRequestBody) (*client.FileStatusCallbackLinkResponse, error) {
+	res, err := c.api.FileStatusCallbackLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) PartnerStatusLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.PartnerStatusLinkJSONRequestBody) (*client.PartnerStatusLinkResponse, error) {
+	res, err := c.api.PartnerStatusLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ResourceReservationCallbackLink(ctx context.Context, federationCallbackId models.FederationCallbackId, body models.ResourceReservationCallbackLinkJSONRequestBody) (*client.ResourceReservationCallbackLinkResponse, error) {
+	res, err := c.api.ResourceReservationCallbackLinkWithResponse(ctx, federationCallbackId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) InstallApp(ctx context.Context, federationContextId models.FederationContextId, body models.InstallAppJSONRequestBody) (*client.InstallAppResponse, error) {
+	res, err := c.api.InstallAppWithResponse(ctx, federationContextId, body, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetAllAppInstances(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, appProviderId models.AppProviderId) (*client.GetAllAppInstancesResponse, error) {
+	res, err := c.api.GetAllAppInstancesWithResponse(ctx, federationContextId, appId, appProviderId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) RemoveApp(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, appInstanceId models.InstanceIdentifier, zoneId models.ZoneIdentifier) (*client.RemoveAppResponse, error) {
+	res, err := c.api.RemoveAppWithResponse(ctx, federationContextId, appId, appInstanceId, zoneId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetAppInstanceDetails(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, appInstanceId models.InstanceIdentifier, zoneId models.ZoneIdentifier) (*client.GetAppInstanceDetailsResponse, error) {
+	res, err := c.api.GetAppInstanceDetailsWithResponse(ctx, federationContextId, appId, appInstanceId, zoneId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) OnboardApplication(ctx context.Context, federationContextId models.FederationContextId, body models.OnboardApplicationJSONRequestBody) (*client.OnboardApplicationResponse, error) {
+	res, err := c.api.OnboardApplicationWithResponse(ctx, federationContextId, body, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) DeleteApp(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier) (*client.DeleteAppResponse, error) {
+	res, err := c.api.DeleteAppWithResponse(ctx, federationContextId, appId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ViewApplication(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier) (*client.ViewApplicationResponse, error) {
+	res, err := c.api.ViewApplicationWithResponse(ctx, federationContextId, appId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) UpdateApplication(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, body models.UpdateApplicationJSONRequestBody) (*client.UpdateApplicationResponse, error) {
+	res, err := c.api.UpdateApplicationWithResponse(ctx, federationContextId, appId, body, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) OnboardExistingAppNewZones(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, body models.OnboardExistingAppNewZonesJSONRequestBody) (*client.OnboardExistingAppNewZonesResponse, error) {
+	res, err := c.api.OnboardExistingAppNewZonesWithResponse(ctx, federationContextId, appId, body, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) DeboardApplication(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, zoneId models.ZoneIdentifier) (*client.DeboardApplicationResponse, error) {
+	res, err := c.api.DeboardApplicationWithResponse(ctx, federationContextId, appId, zoneId, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) LockUnlockApplicationZone(ctx context.Context, federationContextId models.FederationContextId, appId models.AppIdentifier, body models.LockUnlockApplicationZoneJSONRequestBody) (*client.LockUnlockApplicationZoneResponse, error) {
+	res, err := c.api.LockUnlockApplicationZoneWithResponse(ctx, federationContextId, appId, body, c.lcm...)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) RemoveArtefact(ctx context.Context, federationContextId models.FederationContextId, artefactId models.ArtefactId) (*client.RemoveArtefactResponse, error) {
+	res, err := c.api.RemoveArtefactWithResponse(ctx, federationContextId, artefactId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetArtefact(ctx context.Context, federationContextId models.FederationContextId, artefactId models.ArtefactId) (*client.GetArtefactResponse, error) {
+	res, err := c.api.GetArtefactWithResponse(ctx, federationContextId, artefactId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetCandidateZones(ctx context.Context, federationContextId models.FederationContextId, body models.GetCandidateZonesJSONRequestBody) (*client.GetCandidateZonesResponse, error) {
+	res, err := c.api.GetCandidateZonesWithResponse(ctx, federationContextId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) RemoveFile(ctx context.Context, federationContextId models.FederationContextId, fileId models.FileId) (*client.RemoveFileResponse, error) {
+	res, err := c.api.RemoveFileWithResponse(ctx, federationContextId, fileId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ViewFile(ctx context.Context, federationContextId models.FederationContextId, fileId models.FileId) (*client.ViewFileResponse, error) {
+	res, err := c.api.ViewFileWithResponse(ctx, federationContextId, fileId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ViewISVResPool(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId) (*client.ViewISVResPoolResponse, error) {
+	res, err := c.api.ViewISVResPoolWithResponse(ctx, federationContextId, zoneId, appProviderId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateResourcePools records the reservation once the partner OP accepted it.
+// The response is returned along with the error when it cannot be recorded.
+func (c *Client) CreateResourcePools(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, body models.CreateResourcePoolsJSONRequestBody) (*client.CreateResourcePoolsResponse, error) {
+	res, err := c.api.CreateResourcePoolsWithResponse(ctx, federationContextId, zoneId, appProviderId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	if c.recordReservation != nil {
+		if err := c.recordReservation(ctx, newReservation(zoneId, appProviderId, body)); err != nil {
+			return res, errors.Wrap(err, "failed to record resource pool reservation")
+		}
+	}
+	return res, nil
+}
+
+func (c *Client) RemoveISVResPool(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, poolId models.PoolId) (*client.RemoveISVResPoolResponse, error) {
+	res, err := c.api.RemoveISVResPoolWithResponse(ctx, federationContextId, zoneId, appProviderId, poolId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) UpdateISVResPool(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, poolId models.PoolId, body models.UpdateISVResPoolJSONRequestBody) (*client.UpdateISVResPoolResponse, error) {
+	res, err := c.api.UpdateISVResPoolWithResponse(ctx, federationContextId, zoneId, appProviderId, poolId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) DeleteFederationDetails(ctx context.Context, federationContextId models.FederationContextId) (*client.DeleteFederationDetailsResponse, error) {
+	res, err := c.api.DeleteFederationDetailsWithResponse(ctx, federationContextId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetFederationDetails(ctx context.Context, federationContextId models.FederationContextId) (*client.GetFederationDetailsResponse, error) {
+	res, err := c.api.GetFederationDetailsWithResponse(ctx, federationContextId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) UpdateFederation(ctx context.Context, federationContextId models.FederationContextId, body models.UpdateFederationJSONRequestBody) (*client.UpdateFederationResponse, error) {
+	res, err := c.api.UpdateFederationWithResponse(ctx, federationContextId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) AuthenticateDevice(ctx context.Context, federationContextId models.FederationContextId, deviceId models.DeviceId, authToken models.AuthorizationToken) (*client.AuthenticateDeviceResponse, error) {
+	res, err := c.api.AuthenticateDeviceWithResponse(ctx, federationContextId, deviceId, authToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ZoneSubscribe(ctx context.Context, federationContextId models.FederationContextId, body models.ZoneSubscribeJSONRequestBody) (*client.ZoneSubscribeResponse, error) {
+	res, err := c.api.ZoneSubscribeWithResponse(ctx, federationContextId, body)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ZoneUnsubscribe(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier) (*client.ZoneUnsubscribeResponse, error) {
+	res, err := c.api.ZoneUnsubscribeWithResponse(ctx, federationContextId, zoneId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) GetZoneData(ctx context.Context, federationContextId models.FederationContextId, zoneId models.ZoneIdentifier) (*client.GetZoneDataResponse, error) {
+	res, err := c.api.GetZoneDataWithResponse(ctx, federationContextId, zoneId)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkResponse(res.HTTPResponse, res.Body); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
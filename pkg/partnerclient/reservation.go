@@ -0,0 +1,39 @@
+package partnerclient
+
+import (
+	"context"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+)
+
+// ReservationFunc records a resource pool reservation requested to a partner
+// OP, so that the flavours it grants through the callback can be compared
+// against the requested ones.
+type ReservationFunc func(ctx context.Context, reservation *metastore.ResourcePoolReservation) error
+
+// GuestReservation records the reservations requested for the federation we
+// created as guest on the partner OP, to be used as Config.RecordReservation.
+func GuestReservation(store metastore.Client, federationCallbackID string) ReservationFunc {
+	return func(ctx context.Context, reservation *metastore.ResourcePoolReservation) error {
+		reservation.FederationCallbackId = federationCallbackID
+		return store.CreateResourcePoolReservation(ctx, reservation)
+	}
+}
+
+func newReservation(zoneId models.ZoneIdentifier, appProviderId models.AppProviderId, body models.CreateResourcePoolsJSONRequestBody) *metastore.ResourcePoolReservation {
+	reservation := &metastore.ResourcePoolReservation{
+		ZoneId:            zoneId,
+		AppProviderId:     appProviderId,
+		PoolId:            body.ResRequest.PoolId,
+		RequestedFlavours: make([]metastore.ResourcePoolFlavour, 0, len(body.ResRequest.Flavours)),
+	}
+	for _, f := range body.ResRequest.Flavours {
+		reservation.RequestedFlavours = append(reservation.RequestedFlavours, metastore.ResourcePoolFlavour{
+			FlavourId:        f.FlavourId,
+			NumFlavour:       f.NumFlavour,
+			MinNumOfFlavours: f.MinNumOfFlavours,
+		})
+	}
+	return reservation
+}
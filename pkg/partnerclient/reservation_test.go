@@ -0,0 +1,64 @@
+package partnerclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/api/federation/models"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/metastore/mock"
+)
+
+func Test_Client_CreateResourcePools(t *testing.T) {
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"access_token":"token","token_type":"Bearer","expires_in":3600}`))
+			return
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	var recorded []*metastore.ResourcePoolReservation
+	c, err := New(Config{
+		Server: server.URL,
+		RecordReservation: GuestReservation(&mock.FakeMetaStoreClient{
+			CreateResourcePoolReservationFunc: func(reservation *metastore.ResourcePoolReservation) error {
+				recorded = append(recorded, reservation)
+				return nil
+			},
+		}, "callback"),
+		Credentials: models.CallbackCredentials{ClientId: "client", ClientSecret: "secret", TokenUrl: server.URL + "/oauth2/token"},
+	})
+	require.NoError(t, err)
+
+	body := models.CreateResourcePoolsJSONRequestBody{}
+	body.ResRequest.PoolId = "pool"
+	body.ResRequest.Flavours = append(body.ResRequest.Flavours, struct {
+		FlavourId        models.FlavourId `json:"flavourId"`
+		MinNumOfFlavours *int32           `json:"minNumOfFlavours,omitempty"`
+		NumFlavour       int32            `json:"numFlavour"`
+	}{FlavourId: "small", NumFlavour: 4})
+
+	_, err = c.CreateResourcePools(context.Background(), "fed", "zone", "provider", body)
+	require.NoError(t, err)
+	require.Equal(t, []*metastore.ResourcePoolReservation{{
+		FederationCallbackId: "callback",
+		ZoneId:               "zone",
+		AppProviderId:        "provider",
+		PoolId:               "pool",
+		RequestedFlavours:    []metastore.ResourcePoolFlavour{{FlavourId: "small", NumFlavour: 4}},
+	}}, recorded)
+
+	// the reservations refused by the partner OP are not recorded
+	status = http.StatusConflict
+	_, err = c.CreateResourcePools(context.Background(), "fed", "zone", "provider", body)
+	require.Error(t, err)
+	require.Len(t, recorded, 1)
+}
@@ -0,0 +1,138 @@
+package partnerclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// tokenSource caches the access token until it expires. It can be reset when
+// the partner OP rejects a token before its expiry.
+type tokenSource struct {
+	ctx    context.Context
+	config *clientcredentials.Config
+
+	mu    sync.Mutex
+	token *oauth2.Token
+}
+
+func newTokenSource(ctx context.Context, config *clientcredentials.Config) *tokenSource {
+	return &tokenSource{ctx: ctx, config: config}
+}
+
+func (s *tokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token.Valid() {
+		return s.token, nil
+	}
+	token, err := s.config.Token(s.ctx)
+	if err != nil {
+		return nil, err
+	}
+	s.token = token
+	return token, nil
+}
+
+// reset drops the cached token, unless it was already replaced by a newer one.
+func (s *tokenSource) reset(rejected *oauth2.Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.token != nil && s.token.AccessToken == rejected.AccessToken {
+		s.token = nil
+	}
+}
+
+// transport authenticates the requests with an access token and retries the
+// idempotent ones when the partner OP cannot be reached or is unavailable.
+// A request rejected with 401 is sent again once with a new token.
+type transport struct {
+	base       http.RoundTripper
+	tokens     *tokenSource
+	maxRetries int
+	backoff    time.Duration
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	var sent, reauthenticated bool
+	for attempt := 0; ; {
+		token, err := t.tokens.Token()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get partner OP access token")
+		}
+		r := req.Clone(req.Context())
+		if sent && req.GetBody != nil {
+			if r.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		token.SetAuthHeader(r)
+		res, err := t.base.RoundTrip(r)
+		sent = true
+
+		if err == nil && res.StatusCode == http.StatusUnauthorized && !reauthenticated && replayable {
+			reauthenticated = true
+			discard(res)
+			t.tokens.reset(token)
+			continue
+		}
+		if attempt >= t.maxRetries || !replayable || !idempotent(req.Method) || !retryable(res, err) {
+			return res, err
+		}
+		wait := t.backoff << attempt
+		if res != nil {
+			wait = max(wait, retryAfter(res))
+			discard(res)
+		}
+		attempt++
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryAfter returns the delay of the Retry-After header, in seconds.
+func retryAfter(res *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(res.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func discard(res *http.Response) {
+	_, _ = io.Copy(io.Discard, res.Body)
+	res.Body.Close()
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"maps"
+	"os"
+
+	"github.com/pkg/errors"
+)
+
+// Limit is a token bucket refilled with Rate requests per second and holding
+// up to Burst requests.
+type Limit struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// Limits are the limits applied to a partner OP. Global limits all its requests
+// and Operations the requests of each operation, by operation id. MaxInFlight
+// caps the mutating requests processed at the same time for each federation.
+// Unset limits do not apply.
+type Limits struct {
+	Global      *Limit           `json:"global,omitempty"`
+	Operations  map[string]Limit `json:"operations,omitempty"`
+	MaxInFlight int              `json:"maxInFlight,omitempty"`
+}
+
+// Config holds the Default limits and, by client id, the limits of the partner
+// OPs overriding them.
+type Config struct {
+	Default  Limits            `json:"default"`
+	Partners map[string]Limits `json:"partners,omitempty"`
+}
+
+// LoadConfig reads the limits from a JSON file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read rate limits '%s'", path)
+	}
+	config := &Config{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrapf(err, "invalid rate limits '%s'", path)
+	}
+	limits := []Limits{config.Default}
+	for _, l := range config.Partners {
+		limits = append(limits, l)
+	}
+	for _, l := range limits {
+		if err := l.validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid rate limits '%s'", path)
+		}
+	}
+	return config, nil
+}
+
+// limits returns the default limits overridden by the ones of the partner OP.
+func (c *Config) limits(clientID string) Limits {
+	limits := Limits{
+		Global:      c.Default.Global,
+		Operations:  maps.Clone(c.Default.Operations),
+		MaxInFlight: c.Default.MaxInFlight,
+	}
+	partner, ok := c.Partners[clientID]
+	if !ok {
+		return limits
+	}
+	if partner.Global != nil {
+		limits.Global = partner.Global
+	}
+	if limits.Operations == nil {
+		limits.Operations = map[string]Limit{}
+	}
+	maps.Copy(limits.Operations, partner.Operations)
+	if partner.MaxInFlight > 0 {
+		limits.MaxInFlight = partner.MaxInFlight
+	}
+	return limits
+}
+
+func (l Limits) validate() error {
+	check := func(limit Limit) error {
+		if limit.Rate <= 0 || limit.Burst <= 0 {
+			return errors.New("rate and burst must be positive")
+		}
+		return nil
+	}
+	if l.Global != nil {
+		if err := check(*l.Global); err != nil {
+			return err
+		}
+	}
+	for _, limit := range l.Operations {
+		if err := check(limit); err != nil {
+			return err
+		}
+	}
+	if l.MaxInFlight < 0 {
+		return errors.New("maxInFlight must not be negative")
+	}
+	return nil
+}
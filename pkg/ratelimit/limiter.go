@@ -0,0 +1,125 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// sweepInterval is how often the full buckets are dropped.
+const sweepInterval = time.Minute
+
+// Limiter applies the configured limits to the requests of the partner OPs,
+// identified by their client id. The buckets are kept only while they are
+// not full, a full bucket being the same as a new one, and the in-flight
+// counters only while requests are in flight, so that the clients that stop
+// sending requests do not grow the limiter.
+type Limiter struct {
+	config *Config
+
+	mu        sync.Mutex
+	buckets   map[bucketKey]*rate.Limiter
+	inFlight  map[inFlightKey]int
+	lastSweep time.Time
+}
+
+type bucketKey struct {
+	clientID  string
+	operation string
+}
+
+type inFlightKey struct {
+	clientID   string
+	federation string
+}
+
+// New returns a limiter applying the limits of config.
+func New(config *Config) *Limiter {
+	return &Limiter{
+		config:    config,
+		buckets:   map[bucketKey]*rate.Limiter{},
+		inFlight:  map[inFlightKey]int{},
+		lastSweep: time.Now(),
+	}
+}
+
+// Allow takes a token from the global and the operation buckets of the client.
+// When one of them is empty no token is taken, and it returns the time to wait
+// before the request can be allowed.
+func (l *Limiter) Allow(clientID, operation string) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		l.sweep(now)
+	}
+
+	limits := l.config.limits(clientID)
+	var buckets []*rate.Limiter
+	if limits.Global != nil {
+		buckets = append(buckets, l.bucket(bucketKey{clientID: clientID}, *limits.Global))
+	}
+	if limit, ok := limits.Operations[operation]; ok {
+		buckets = append(buckets, l.bucket(bucketKey{clientID: clientID, operation: operation}, limit))
+	}
+
+	reservations := make([]*rate.Reservation, 0, len(buckets))
+	var wait time.Duration
+	for _, b := range buckets {
+		r := b.ReserveN(now, 1)
+		reservations = append(reservations, r)
+		wait = max(wait, r.DelayFrom(now))
+	}
+	if wait == 0 {
+		return 0, true
+	}
+	for _, r := range reservations {
+		r.CancelAt(now)
+	}
+	return wait, false
+}
+
+// Acquire takes one of the in-flight slots of the client for the federation.
+// The release function must be called once the request is processed.
+func (l *Limiter) Acquire(clientID, federation string) (release func(), ok bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := l.config.limits(clientID).MaxInFlight
+	if limit == 0 {
+		return func() {}, true
+	}
+	key := inFlightKey{clientID: clientID, federation: federation}
+	if l.inFlight[key] >= limit {
+		return nil, false
+	}
+	l.inFlight[key]++
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if l.inFlight[key]--; l.inFlight[key] <= 0 {
+			delete(l.inFlight, key)
+		}
+	}, true
+}
+
+// sweep drops the buckets that are full at now.
+func (l *Limiter) sweep(now time.Time) {
+	for key, b := range l.buckets {
+		if b.TokensAt(now) >= float64(b.Burst()) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (l *Limiter) bucket(key bucketKey, limit Limit) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)
+		l.buckets[key] = b
+	}
+	return b
+}
@@ -0,0 +1,97 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Limiter_Allow(t *testing.T) {
+	config := &Config{
+		Default: Limits{
+			Global:     &Limit{Rate: 0.001, Burst: 3},
+			Operations: map[string]Limit{"InstallApp": {Rate: 0.001, Burst: 1}},
+		},
+		Partners: map[string]Limits{
+			"trusted": {Operations: map[string]Limit{"InstallApp": {Rate: 0.001, Burst: 2}}},
+		},
+	}
+
+	t.Run("Operation limit", func(t *testing.T) {
+		l := New(config)
+		_, ok := l.Allow("partner", "InstallApp")
+		require.True(t, ok)
+		wait, ok := l.Allow("partner", "InstallApp")
+		require.False(t, ok)
+		require.Positive(t, wait)
+
+		// the rejected request took no token from the global bucket
+		for range 2 {
+			_, ok = l.Allow("partner", "GetApp")
+			require.True(t, ok)
+		}
+		_, ok = l.Allow("partner", "GetApp")
+		require.False(t, ok)
+	})
+
+	t.Run("Partner limits override the default ones", func(t *testing.T) {
+		l := New(config)
+		for range 2 {
+			_, ok := l.Allow("trusted", "InstallApp")
+			require.True(t, ok)
+		}
+		_, ok := l.Allow("trusted", "InstallApp")
+		require.False(t, ok)
+	})
+
+	t.Run("Limits are kept by client", func(t *testing.T) {
+		l := New(config)
+		_, ok := l.Allow("partner", "InstallApp")
+		require.True(t, ok)
+		_, ok = l.Allow("other", "InstallApp")
+		require.True(t, ok)
+	})
+
+	t.Run("Full buckets are dropped", func(t *testing.T) {
+		l := New(&Config{Default: Limits{Global: &Limit{Rate: 1, Burst: 2}}})
+		_, ok := l.Allow("partner", "GetApp")
+		require.True(t, ok)
+		require.Len(t, l.buckets, 1)
+
+		l.sweep(time.Now())
+		require.Len(t, l.buckets, 1)
+		l.sweep(time.Now().Add(time.Second))
+		require.Empty(t, l.buckets)
+	})
+}
+
+func Test_Limiter_Acquire(t *testing.T) {
+	l := New(&Config{
+		Default:  Limits{MaxInFlight: 1},
+		Partners: map[string]Limits{"trusted": {MaxInFlight: 2}},
+	})
+
+	release, ok := l.Acquire("partner", "fed")
+	require.True(t, ok)
+	_, ok = l.Acquire("partner", "fed")
+	require.False(t, ok)
+	_, ok = l.Acquire("partner", "other-fed")
+	require.True(t, ok)
+
+	release()
+	_, ok = l.Acquire("partner", "fed")
+	require.True(t, ok)
+
+	for range 2 {
+		_, ok = l.Acquire("trusted", "fed")
+		require.True(t, ok)
+	}
+	_, ok = l.Acquire("trusted", "fed")
+	require.False(t, ok)
+
+	release, ok = l.Acquire("other", "fed")
+	require.True(t, ok)
+	release()
+	require.NotContains(t, l.inFlight, inFlightKey{clientID: "other", federation: "fed"})
+}
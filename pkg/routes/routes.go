@@ -0,0 +1,57 @@
+// Package routes indexes the operations of an OpenAPI spec by the Echo route
+// serving them, for the middlewares that depend on the requested operation.
+package routes
+
+import (
+	"regexp"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var pathParam = regexp.MustCompile(`\{([^}]+)\}`)
+
+// Operations are the operations of an OpenAPI spec keyed by method and route
+// path in the Echo format (/:param).
+type Operations struct {
+	security   openapi3.SecurityRequirements
+	operations map[string]*openapi3.Operation
+}
+
+// New indexes the operations of the spec.
+func New(swagger *openapi3.T) *Operations {
+	o := &Operations{security: swagger.Security, operations: map[string]*openapi3.Operation{}}
+	for path, item := range swagger.Paths {
+		route := pathParam.ReplaceAllString(path, ":$1")
+		for method, op := range item.Operations() {
+			o.operations[key(method, route)] = op
+		}
+	}
+	return o
+}
+
+// ID returns the id of the operation served by the route, empty when the
+// route is not in the spec.
+func (o *Operations) ID(method, route string) string {
+	if op, ok := o.operations[key(method, route)]; ok {
+		return op.OperationID
+	}
+	return ""
+}
+
+// Security returns the security requirements of the operation served by the
+// route, falling back to the requirements of the spec itself. It returns nil
+// when the route is not in the spec.
+func (o *Operations) Security(method, route string) openapi3.SecurityRequirements {
+	op, ok := o.operations[key(method, route)]
+	if !ok {
+		return nil
+	}
+	if op.Security != nil {
+		return *op.Security
+	}
+	return o.security
+}
+
+func key(method, route string) string {
+	return method + " " + route
+}
@@ -0,0 +1,46 @@
+package routes
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+const spec = `
+openapi: 3.0.3
+info:
+  title: test
+  version: 1.0.0
+security:
+  - oAuth2: [fed-mgmt]
+paths:
+  /{federationContextId}/partner:
+    get:
+      operationId: GetFederationDetails
+      responses:
+        "200":
+          description: ok
+    delete:
+      operationId: DeleteFederationDetails
+      security:
+        - oAuth2: [fed-admin]
+      responses:
+        "200":
+          description: ok
+`
+
+func Test_Operations(t *testing.T) {
+	swagger, err := openapi3.NewLoader().LoadFromData([]byte(spec))
+	require.NoError(t, err)
+	o := New(swagger)
+
+	require.Equal(t, "GetFederationDetails", o.ID(http.MethodGet, "/:federationContextId/partner"))
+	require.Equal(t, "DeleteFederationDetails", o.ID(http.MethodDelete, "/:federationContextId/partner"))
+	require.Empty(t, o.ID(http.MethodGet, "/{federationContextId}/partner"))
+
+	require.Equal(t, swagger.Security, o.Security(http.MethodGet, "/:federationContextId/partner"))
+	require.Equal(t, openapi3.SecurityRequirements{{"oAuth2": {"fed-admin"}}}, o.Security(http.MethodDelete, "/:federationContextId/partner"))
+	require.Nil(t, o.Security(http.MethodPost, "/:federationContextId/partner"))
+}
@@ -0,0 +1,75 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+)
+
+// Server returns the config of a server presenting cert. When clientCAs is
+// not nil, clients must present a certificate signed by one of them (mTLS).
+func Server(cert *Certificate, clientCAs *CertPool) *tls.Config {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return cert.Get(), nil
+		},
+	}
+	if clientCAs != nil {
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = clientCAs.Get()
+		// the CAs are taken from the pool on every handshake to pick up the rotated ones
+		config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c := config.Clone()
+			c.ClientCAs = clientCAs.Get()
+			c.GetConfigForClient = nil
+			return c, nil
+		}
+	}
+	return config
+}
+
+// Client returns the config of a client presenting cert, when not nil, and
+// verifying serverName with rootCAs, or with the system CAs when nil.
+func Client(cert *Certificate, rootCAs *CertPool, serverName string) *tls.Config {
+	config := &tls.Config{MinVersion: tls.VersionTLS12, ServerName: serverName}
+	if cert != nil {
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return cert.Get(), nil
+		}
+	}
+	if rootCAs != nil {
+		config.RootCAs = rootCAs.Get()
+	}
+	return config
+}
+
+// Transport returns an HTTP transport presenting cert, when not nil, and
+// verifying the servers with rootCAs, or with the system CAs when nil.
+// tls.Config has no hook to change the root CAs of a client, so the config is
+// built on every dial with the current pool and the dialled host.
+func Transport(cert *Certificate, rootCAs *CertPool) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// used for the servers reached through a proxy, with the CAs loaded at startup
+	transport.TLSClientConfig = Client(cert, rootCAs, "")
+	dialer := &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}
+	transport.DialTLSContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := dialer.DialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		tlsConn := tls.Client(conn, Client(cert, rootCAs, host))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	}
+	return transport
+}
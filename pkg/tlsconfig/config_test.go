@@ -0,0 +1,190 @@
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testCA{cert: cert, key: key}
+}
+
+// writeCA writes the CA certificate to path.
+func (ca *testCA) writeCA(t *testing.T, path string) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw}), 0o600))
+}
+
+// writeCert issues a certificate for name and ips and writes it with its key to certFile and keyFile.
+func (ca *testCA) writeCert(t *testing.T, name string, ips []net.IP, serial int64, certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		IPAddresses:  ips,
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}), 0o600))
+}
+
+var localIPs = []net.IP{net.IPv4(127, 0, 0, 1)}
+
+func Test_MutualTLS(t *testing.T) {
+	checkInterval = 0
+	dir := t.TempDir()
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	ca := newTestCA(t, "ca")
+	ca.writeCA(t, file("ca.pem"))
+	ca.writeCert(t, "localhost", localIPs, 2, file("server.pem"), file("server-key.pem"))
+	ca.writeCert(t, "partner", localIPs, 3, file("client.pem"), file("client-key.pem"))
+
+	serverCert, err := LoadCertificate(file("server.pem"), file("server-key.pem"))
+	require.NoError(t, err)
+	clientCert, err := LoadCertificate(file("client.pem"), file("client-key.pem"))
+	require.NoError(t, err)
+	cas, err := LoadCertPool(file("ca.pem"))
+	require.NoError(t, err)
+
+	var subject string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		subject = r.TLS.VerifiedChains[0][0].Subject.String()
+	}))
+	server.TLS = Server(serverCert, cas)
+	server.StartTLS()
+	defer server.Close()
+
+	get := func(cert *Certificate) (*http.Response, error) {
+		client := &http.Client{Transport: Transport(cert, cas)}
+		return client.Get(server.URL)
+	}
+
+	t.Run("Client certificate verified", func(t *testing.T) {
+		res, err := get(clientCert)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, "CN=partner", subject)
+	})
+
+	t.Run("Missing client certificate", func(t *testing.T) {
+		_, err := get(nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Rotated CA and certificates", func(t *testing.T) {
+		rotated := newTestCA(t, "rotated")
+		rotated.writeCA(t, file("ca.pem"))
+		rotated.writeCert(t, "localhost", localIPs, 4, file("server.pem"), file("server-key.pem"))
+		rotated.writeCert(t, "partner", localIPs, 5, file("client.pem"), file("client-key.pem"))
+		// make sure the modification times change on file systems with a coarse resolution
+		later := time.Now().Add(time.Minute)
+		for _, name := range []string{"ca.pem", "server.pem", "server-key.pem", "client.pem", "client-key.pem"} {
+			require.NoError(t, os.Chtimes(file(name), later, later))
+		}
+
+		res, err := get(clientCert)
+		require.NoError(t, err)
+		res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, int64(4), res.TLS.PeerCertificates[0].SerialNumber.Int64())
+	})
+
+	t.Run("Invalid files keep the loaded certificate", func(t *testing.T) {
+		loaded := serverCert.Get()
+		require.NoError(t, os.WriteFile(file("server.pem"), []byte("invalid"), 0o600))
+		later := time.Now().Add(2 * time.Minute)
+		require.NoError(t, os.Chtimes(file("server.pem"), later, later))
+		require.Same(t, loaded, serverCert.Get())
+	})
+}
+
+func Test_Transport_ServerName(t *testing.T) {
+	dir := t.TempDir()
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	ca := newTestCA(t, "ca")
+	ca.writeCA(t, file("ca.pem"))
+	cas, err := LoadCertPool(file("ca.pem"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		ips     []net.IP
+		wantErr bool
+	}{
+		{
+			name: "Matching IP address",
+			ips:  localIPs,
+		},
+		{
+			name:    "Certificate of another address",
+			ips:     []net.IP{net.IPv4(10, 0, 0, 1)},
+			wantErr: true,
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ca.writeCert(t, "partner", tt.ips, int64(i+2), file("server.pem"), file("server-key.pem"))
+			serverCert, err := LoadCertificate(file("server.pem"), file("server-key.pem"))
+			require.NoError(t, err)
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+			server.TLS = &tls.Config{Certificates: []tls.Certificate{*serverCert.Get()}}
+			server.StartTLS()
+			defer server.Close()
+
+			// the server is addressed by its IP, so no server name is sent
+			client := &http.Client{Transport: Transport(nil, cas)}
+			res, err := client.Get(server.URL)
+			if tt.wantErr {
+				require.ErrorContains(t, err, "certificate is valid for 10.0.0.1, not 127.0.0.1")
+				return
+			}
+			require.NoError(t, err)
+			res.Body.Close()
+		})
+	}
+}
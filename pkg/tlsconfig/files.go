@@ -0,0 +1,137 @@
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// checkInterval limits how often the files are checked for changes.
+var checkInterval = 10 * time.Second
+
+// watchedFiles loads a value from a set of files and loads it again when any
+// of them changes, so certificates can be rotated without a restart.
+// If the new files cannot be loaded the previous value is kept.
+type watchedFiles[T any] struct {
+	paths []string
+	load  func() (T, error)
+
+	mu        sync.Mutex
+	value     T
+	modTimes  []time.Time
+	checkedAt time.Time
+}
+
+func newWatchedFiles[T any](load func() (T, error), paths ...string) (*watchedFiles[T], error) {
+	w := &watchedFiles[T]{paths: paths, load: load}
+	modTimes, err := w.stat()
+	if err != nil {
+		return nil, err
+	}
+	if w.value, err = load(); err != nil {
+		return nil, err
+	}
+	w.modTimes, w.checkedAt = modTimes, time.Now()
+	return w, nil
+}
+
+func (w *watchedFiles[T]) get() T {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if time.Since(w.checkedAt) < checkInterval {
+		return w.value
+	}
+	w.checkedAt = time.Now()
+	modTimes, err := w.stat()
+	if err != nil {
+		log.WithError(err).Warn("unable to check tls files, keeping the loaded ones")
+		return w.value
+	}
+	changed := false
+	for i := range modTimes {
+		changed = changed || !modTimes[i].Equal(w.modTimes[i])
+	}
+	if !changed {
+		return w.value
+	}
+	value, err := w.load()
+	if err != nil {
+		log.WithError(err).Warn("unable to reload tls files, keeping the loaded ones")
+		return w.value
+	}
+	log.WithField("files", w.paths).Info("tls files reloaded")
+	w.value, w.modTimes = value, modTimes
+	return w.value
+}
+
+func (w *watchedFiles[T]) stat() ([]time.Time, error) {
+	modTimes := make([]time.Time, len(w.paths))
+	for i, path := range w.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read '%s'", path)
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+// Certificate is a PEM encoded certificate and key pair, reloaded when the files change.
+type Certificate struct {
+	files *watchedFiles[*tls.Certificate]
+}
+
+// LoadCertificate reads the certificate chain of certFile and the key of keyFile.
+func LoadCertificate(certFile, keyFile string) (*Certificate, error) {
+	files, err := newWatchedFiles(func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid certificate '%s'", certFile)
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &Certificate{files: files}, nil
+}
+
+// Get returns the certificate, loading the files again if they changed.
+func (c *Certificate) Get() *tls.Certificate {
+	return c.files.get()
+}
+
+// CertPool is a set of PEM encoded CA certificates, reloaded when the file changes.
+type CertPool struct {
+	files *watchedFiles[*x509.CertPool]
+}
+
+// LoadCertPool reads the CA certificates of path.
+func LoadCertPool(path string) (*CertPool, error) {
+	files, err := newWatchedFiles(func() (*x509.CertPool, error) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read CA certificates '%s'", path)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, errors.Errorf("no CA certificates found in '%s'", path)
+		}
+		return pool, nil
+	}, path)
+	if err != nil {
+		return nil, err
+	}
+	return &CertPool{files: files}, nil
+}
+
+// Get returns the CA certificates, loading the file again if it changed.
+func (p *CertPool) Get() *x509.CertPool {
+	return p.files.get()
+}
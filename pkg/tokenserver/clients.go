@@ -0,0 +1,52 @@
+package tokenserver
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Client is a partner OP allowed to request access tokens. The secret is given
+// either in clear or as a bcrypt hash.
+type Client struct {
+	ClientID         string   `json:"clientId"`
+	ClientSecret     string   `json:"clientSecret,omitempty"`
+	ClientSecretHash string   `json:"clientSecretHash,omitempty"`
+	Scopes           []string `json:"scopes,omitempty"`
+}
+
+// Clients are the registered partner OPs, by client id.
+type Clients map[string]Client
+
+// LoadClients reads the registered partner OPs from a JSON file holding a list of clients.
+func LoadClients(path string) (Clients, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read clients '%s'", path)
+	}
+	var list []Client
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrapf(err, "invalid clients '%s'", path)
+	}
+	clients := make(Clients, len(list))
+	for _, c := range list {
+		if c.ClientID == "" || (c.ClientSecret == "" && c.ClientSecretHash == "") {
+			return nil, errors.Errorf("invalid clients '%s': every client needs an id and a secret", path)
+		}
+		if _, ok := clients[c.ClientID]; ok {
+			return nil, errors.Errorf("invalid clients '%s': client '%s' is duplicated", path, c.ClientID)
+		}
+		clients[c.ClientID] = c
+	}
+	return clients, nil
+}
+
+func (c *Client) authenticate(secret string) bool {
+	if c.ClientSecretHash != "" {
+		return bcrypt.CompareHashAndPassword([]byte(c.ClientSecretHash), []byte(secret)) == nil
+	}
+	return subtle.ConstantTimeCompare([]byte(c.ClientSecret), []byte(secret)) == 1
+}
@@ -0,0 +1,192 @@
+package tokenserver
+
+import (
+	"crypto"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+const (
+	TokenPath = "/oauth2/token"
+	KeysPath  = "/oauth2/jwks"
+
+	grantTypeClientCredentials = "client_credentials"
+)
+
+// Server is an OAuth2 authorization server issuing access tokens to the
+// registered partner OPs with the client credentials grant (RFC 6749 section 4.4).
+type Server struct {
+	clients  Clients
+	key      crypto.Signer
+	method   jwt.SigningMethod
+	keySet   *jwks.KeySet
+	issuer   string
+	audience string
+	ttl      time.Duration
+}
+
+// New returns a server signing tokens with key. The tokens are valid for ttl and,
+// when not empty, are issued for audience.
+func New(clients Clients, key crypto.Signer, issuer, audience string, ttl time.Duration) (*Server, error) {
+	method, err := signingMethod(key)
+	if err != nil {
+		return nil, err
+	}
+	kid, err := keyID(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		clients:  clients,
+		key:      key,
+		method:   method,
+		keySet:   &jwks.KeySet{Keys: []jwks.Key{{ID: kid, Algorithm: method.Alg(), Key: key.Public()}}},
+		issuer:   issuer,
+		audience: audience,
+		ttl:      ttl,
+	}, nil
+}
+
+// KeySet returns the keys verifying the issued tokens.
+func (s *Server) KeySet() *jwks.KeySet {
+	return s.keySet
+}
+
+// Middleware serves the token and key set endpoints. It must be registered with
+// Echo.Pre so that they are not subject to the authentication and validation
+// of the federation api.
+func (s *Server) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			switch c.Request().URL.Path {
+			case TokenPath:
+				if c.Request().Method != http.MethodPost {
+					return c.NoContent(http.StatusMethodNotAllowed)
+				}
+				return s.token(c)
+			case KeysPath:
+				if c.Request().Method != http.MethodGet {
+					return c.NoContent(http.StatusMethodNotAllowed)
+				}
+				return s.keys(c)
+			}
+			return next(c)
+		}
+	}
+}
+
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+}
+
+type errorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func (s *Server) token(c echo.Context) error {
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	if grantType := c.FormValue("grant_type"); grantType != grantTypeClientCredentials {
+		return sendError(c, http.StatusBadRequest, "unsupported_grant_type", "only the client_credentials grant is supported")
+	}
+	client, err := s.authenticate(c)
+	if err != nil {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="oauth2"`)
+		return sendError(c, http.StatusUnauthorized, "invalid_client", err.Error())
+	}
+	scopes := client.Scopes
+	if requested := strings.Fields(c.FormValue("scope")); len(requested) > 0 {
+		for _, scope := range requested {
+			if !slices.Contains(client.Scopes, scope) {
+				return sendError(c, http.StatusBadRequest, "invalid_scope", "scope '"+scope+"' is not allowed")
+			}
+		}
+		scopes = requested
+	}
+
+	now := time.Now()
+	jti := make([]byte, 16)
+	if _, err := rand.Read(jti); err != nil {
+		return sendError(c, http.StatusInternalServerError, "server_error", "unable to issue token")
+	}
+	claims := jwt.MapClaims{
+		"iss":       s.issuer,
+		"sub":       client.ClientID,
+		"client_id": client.ClientID,
+		"iat":       now.Unix(),
+		"exp":       now.Add(s.ttl).Unix(),
+		"jti":       hex.EncodeToString(jti),
+	}
+	if s.audience != "" {
+		claims["aud"] = s.audience
+	}
+	if len(scopes) > 0 {
+		claims["scope"] = strings.Join(scopes, " ")
+	}
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header["kid"] = s.keySet.Keys[0].ID
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return sendError(c, http.StatusInternalServerError, "server_error", "unable to issue token")
+	}
+
+	return c.JSON(http.StatusOK, tokenResponse{
+		AccessToken: signed,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(s.ttl.Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	})
+}
+
+// authenticate checks the client credentials, sent with HTTP Basic authentication
+// or in the request body.
+func (s *Server) authenticate(c echo.Context) (*Client, error) {
+	id, secret, ok := c.Request().BasicAuth()
+	if ok {
+		// credentials are form encoded before being sent with Basic authentication (RFC 6749 section 2.3.1)
+		var err error
+		if id, err = url.QueryUnescape(id); err != nil {
+			return nil, errors.New("invalid client credentials")
+		}
+		if secret, err = url.QueryUnescape(secret); err != nil {
+			return nil, errors.New("invalid client credentials")
+		}
+	} else {
+		id, secret = c.FormValue("client_id"), c.FormValue("client_secret")
+	}
+	if id == "" {
+		return nil, errors.New("missing client credentials")
+	}
+	client, ok := s.clients[id]
+	if !ok || !client.authenticate(secret) {
+		return nil, errors.New("invalid client credentials")
+	}
+	return &client, nil
+}
+
+func (s *Server) keys(c echo.Context) error {
+	data, err := jwks.Marshal(s.keySet)
+	if err != nil {
+		return sendError(c, http.StatusInternalServerError, "server_error", err.Error())
+	}
+	return c.Blob(http.StatusOK, "application/jwk-set+json", data)
+}
+
+func sendError(c echo.Context, status int, code, description string) error {
+	return c.JSON(status, errorResponse{Error: code, ErrorDescription: description})
+}
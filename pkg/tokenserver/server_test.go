@@ -0,0 +1,86 @@
+package tokenserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/clientauth"
+	"github.com/neonephos-katalis/opg-ewbi-api/pkg/jwks"
+)
+
+func Test_Server_token(t *testing.T) {
+	key, err := GenerateSigningKey()
+	require.NoError(t, err)
+	s, err := New(Clients{
+		"partner": {ClientID: "partner", ClientSecret: "secret", Scopes: []string{"fed-mgmt", "zone-mgmt"}},
+	}, key, "https://op.example", "opg-ewbi-api", 5*time.Minute)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.Pre(s.Middleware())
+	request := func(form url.Values, user, password string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, TokenPath, strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		if user != "" {
+			req.SetBasicAuth(user, password)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Issue token verifiable with the published keys", func(t *testing.T) {
+		rec := request(url.Values{"grant_type": {"client_credentials"}, "scope": {"zone-mgmt"}}, "partner", "secret")
+		require.Equal(t, http.StatusOK, rec.Code)
+		var res tokenResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+		require.Equal(t, "Bearer", res.TokenType)
+		require.Equal(t, int64(300), res.ExpiresIn)
+
+		rec = httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, KeysPath, nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		keys, err := jwks.Parse(rec.Body.Bytes())
+		require.NoError(t, err)
+
+		validator := clientauth.NewJWTValidator(keys.Keyfunc, "https://op.example", "opg-ewbi-api", "")
+		claims, err := validator.ValidateToken(context.Background(), res.AccessToken)
+		require.NoError(t, err)
+		require.Equal(t, &clientauth.Claims{ClientID: "partner", Scopes: []string{"zone-mgmt"}}, claims)
+	})
+
+	t.Run("Credentials in the body", func(t *testing.T) {
+		rec := request(url.Values{"grant_type": {"client_credentials"}, "client_id": {"partner"}, "client_secret": {"secret"}}, "", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	tests := []struct {
+		name     string
+		form     url.Values
+		password string
+		status   int
+		error    string
+	}{
+		{"Wrong secret", url.Values{"grant_type": {"client_credentials"}}, "other", http.StatusUnauthorized, "invalid_client"},
+		{"Unsupported grant", url.Values{"grant_type": {"password"}}, "secret", http.StatusBadRequest, "unsupported_grant_type"},
+		{"Scope not allowed", url.Values{"grant_type": {"client_credentials"}, "scope": {"admin"}}, "secret", http.StatusBadRequest, "invalid_scope"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := request(tt.form, "partner", tt.password)
+			require.Equal(t, tt.status, rec.Code)
+			var res errorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &res))
+			require.Equal(t, tt.error, res.Error)
+		})
+	}
+}